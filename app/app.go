@@ -34,6 +34,10 @@ import (
 	paramsClient "github.com/maticnetwork/heimdall/params/client"
 	"github.com/maticnetwork/heimdall/params/subspace"
 	paramsTypes "github.com/maticnetwork/heimdall/params/types"
+	"github.com/maticnetwork/heimdall/sidetx"
+	sidetxTypes "github.com/maticnetwork/heimdall/sidetx/types"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
 	"github.com/maticnetwork/heimdall/staking"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	"github.com/maticnetwork/heimdall/supply"
@@ -73,6 +77,8 @@ var (
 		bor.AppModuleBasic{},
 		clerk.AppModuleBasic{},
 		topup.AppModuleBasic{},
+		sidetx.AppModuleBasic{},
+		slashing.AppModuleBasic{},
 		gov.NewAppModuleBasic(paramsClient.ProposalHandler),
 	)
 
@@ -106,6 +112,8 @@ type HeimdallApp struct {
 	BorKeeper        bor.Keeper
 	ClerkKeeper      clerk.Keeper
 	TopupKeeper      topup.Keeper
+	SideTxKeeper     sidetx.Keeper
+	SlashingKeeper   slashing.Keeper
 	// param keeper
 	ParamsKeeper params.Keeper
 
@@ -168,6 +176,30 @@ func (d ModuleCommunicator) SendCoins(ctx sdk.Context, fromAddr types.HeimdallAd
 	return d.App.BankKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
 }
 
+// GetValidatorLiveness returns missed blocks stats of validator
+func (d ModuleCommunicator) GetValidatorLiveness(ctx sdk.Context, valID types.ValidatorID) (slashingTypes.ValidatorLiveness, bool) {
+	return d.App.SlashingKeeper.GetValidatorLiveness(ctx, valID)
+}
+
+// GetBufferedCheckpointProposers returns proposers of checkpoints waiting in buffer of each checkpoint chain
+func (d ModuleCommunicator) GetBufferedCheckpointProposers(ctx sdk.Context) (proposers []types.HeimdallAddress) {
+	for _, chain := range d.App.ChainKeeper.GetParams(ctx).ChainParams.GetAllCheckpointChains() {
+		ck, _, err := d.App.CheckpointKeeper.ForChain(ctx, chain.ChainID)
+		if err != nil {
+			continue
+		}
+
+		checkpoint, err := ck.GetCheckpointFromBuffer(ctx)
+		if err != nil {
+			continue
+		}
+
+		proposers = append(proposers, checkpoint.Proposer)
+	}
+
+	return proposers
+}
+
 //
 // Heimdall app
 //
@@ -202,6 +234,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		borTypes.StoreKey,
 		clerkTypes.StoreKey,
 		topupTypes.StoreKey,
+		sidetxTypes.StoreKey,
+		slashingTypes.StoreKey,
 		paramsTypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramsTypes.TStoreKey)
@@ -227,6 +261,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	app.subspaces[borTypes.ModuleName] = app.ParamsKeeper.Subspace(borTypes.DefaultParamspace)
 	app.subspaces[clerkTypes.ModuleName] = app.ParamsKeeper.Subspace(clerkTypes.DefaultParamspace)
 	app.subspaces[topupTypes.ModuleName] = app.ParamsKeeper.Subspace(topupTypes.DefaultParamspace)
+	app.subspaces[sidetxTypes.ModuleName] = app.ParamsKeeper.Subspace(sidetxTypes.DefaultParamspace)
+	app.subspaces[slashingTypes.ModuleName] = app.ParamsKeeper.Subspace(slashingTypes.DefaultParamspace)
 	//
 	// Contract caller
 	//
@@ -348,6 +384,23 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.StakingKeeper,
 	)
 
+	// side-tx keeper, side and post handlers are registered along with routes
+	app.SideTxKeeper = sidetx.NewKeeper(
+		app.cdc,
+		keys[sidetxTypes.StoreKey],
+		app.subspaces[sidetxTypes.ModuleName],
+		sidetxTypes.DefaultCodespace,
+		app.StakingKeeper,
+	)
+
+	app.SlashingKeeper = slashing.NewKeeper(
+		app.cdc,
+		keys[slashingTypes.StoreKey],
+		app.subspaces[slashingTypes.ModuleName],
+		slashingTypes.DefaultCodespace,
+		app.StakingKeeper,
+	)
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -363,6 +416,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		bor.NewAppModule(app.BorKeeper, &app.caller),
 		clerk.NewAppModule(app.ClerkKeeper, &app.caller),
 		topup.NewAppModule(app.TopupKeeper, &app.caller),
+		sidetx.NewAppModule(app.SideTxKeeper),
+		slashing.NewAppModule(app.SlashingKeeper),
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -378,10 +433,12 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		borTypes.ModuleName,
 		clerkTypes.ModuleName,
 		topupTypes.ModuleName,
+		sidetxTypes.ModuleName,
+		slashingTypes.ModuleName,
 	)
 
-	// register message routes and query routes
-	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	// register message routes and query routes, msgs of side modules wait for side-tx votes
+	sidetx.RegisterRoutes(app.mm, app.SideTxKeeper, app.Router(), app.QueryRouter())
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
@@ -528,8 +585,13 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 		app.AccountKeeper.RemoveBlockProposer(ctx)
 	}
 
+	// apply signer rotations staged until checkpoint ack
+	app.StakingKeeper.ApplyPendingSignerUpdates(ctx)
+
 	var tmValUpdates []abci.ValidatorUpdate
-	if ctx.BlockHeader().NumTxs > 0 {
+	if ctx.BlockHeader().NumTxs > 0 || app.StakingKeeper.IsValidatorSetChanged(ctx) {
+		app.StakingKeeper.SetValidatorSetChanged(ctx, false)
+
 		// --- Start update to new validators
 		currentValidatorSet := app.StakingKeeper.GetValidatorSet(ctx)
 		allValidators := app.StakingKeeper.GetAllValidators(ctx)
@@ -574,11 +636,12 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 	}
 
 	// end block
-	app.mm.EndBlock(ctx, req)
+	endBlockResponse := app.mm.EndBlock(ctx, req)
 
 	// send validator updates to peppermint
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: tmValUpdates,
+		Events:           endBlockResponse.Events,
 	}
 }
 
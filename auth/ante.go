@@ -14,6 +14,7 @@ import (
 	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
 )
 
 var (
@@ -100,7 +101,15 @@ func NewAnteHandler(
 		if !ok {
 			return newCtx, sdk.ErrInternal("Invalid param tx fees").Result(), true
 		}
-		feeForTx := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: amount}} // stdTx.Fee.Amount
+
+		// fees and gas are charged per msg for batched txs
+		msgCount := len(stdTx.GetMsgs())
+		if msgCount > authTypes.MaxMsgsPerTx {
+			newCtx = SetGasMeter(simulate, ctx, 0)
+			return newCtx, sdk.ErrUnknownRequest(fmt.Sprintf("too many msgs in tx; max %d", authTypes.MaxMsgsPerTx)).Result(), true
+		}
+		feeForTx := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: amount.MulRaw(int64(msgCount))}} // stdTx.Fee.Amount
+		gasForTx *= uint64(msgCount)
 
 		// checkpoint gas limit
 		if stdTx.Msg.Type() == "checkpoint" && stdTx.Msg.Route() == "checkpoint" {
@@ -177,9 +186,17 @@ func NewAnteHandler(
 		chainParams := chainKeeper.GetParams(ctx)
 
 		// check main chain tx is confirmed transaction
-		mainTxMsg, ok := stdTx.Msg.(MainTxMsg)
-		if ok && !contractCaller.IsTxConfirmed(ctx.BlockTime(), mainTxMsg.GetTxHash().EthHash(), chainParams.TxConfirmationTime) {
-			return newCtx, sdk.ErrInternal(fmt.Sprintf("Not enough tx confirmations for %s", mainTxMsg.GetTxHash().Hex())).Result(), true
+		for _, msg := range stdTx.GetMsgs() {
+			// side-tx msgs are verified on main chain by validator votes, not in ante handler
+			if _, isSideTx := msg.(hmModule.SideTxMsg); isSideTx {
+				continue
+			}
+
+			// TODO remove once remaining main tx msgs are side-tx msgs (see sidetx package)
+			mainTxMsg, ok := msg.(MainTxMsg)
+			if ok && !contractCaller.IsTxConfirmed(ctx.BlockTime(), mainTxMsg.GetTxHash().EthHash(), chainParams.TxConfirmationTime) {
+				return newCtx, sdk.ErrInternal(fmt.Sprintf("Not enough tx confirmations for %s", mainTxMsg.GetTxHash().Hex())).Result(), true
+			}
 		}
 
 		// stdSigs contains the sequence number, account number, and signatures.
@@ -333,5 +350,5 @@ func GetSignBytes(chainID string, stdTx authTypes.StdTx, acc authTypes.Account,
 		accNum = acc.GetAccountNumber()
 	}
 
-	return authTypes.StdSignBytes(chainID, accNum, acc.GetSequence(), stdTx.Msg, stdTx.Memo)
+	return authTypes.StdBatchSignBytes(chainID, accNum, acc.GetSequence(), stdTx.GetMsgs(), stdTx.Memo)
 }
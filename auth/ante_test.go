@@ -382,6 +382,47 @@ func (suite *AnteTestSuite) TestFees() {
 	require.True(sdk.IntEq(t, happ.AccountKeeper.GetAccount(ctx, hmTypes.AccAddressToHeimdallAddress(addr1)).GetCoins().AmountOf(authTypes.FeeToken), sdk.NewInt(0)))
 }
 
+func (suite *AnteTestSuite) TestBatchFees() {
+	t, happ, ctx, anteHandler := suite.T(), suite.app, suite.ctx, suite.anteHandler
+
+	// keys and addresses
+	priv1, _, addr1 := sdkAuth.KeyTestPubAddr()
+
+	// set the accounts with fees for single msg
+	amt, _ := sdk.NewIntFromString(authTypes.DefaultTxFees)
+	acc1 := happ.AccountKeeper.NewAccountWithAddress(ctx, hmTypes.AccAddressToHeimdallAddress(addr1))
+	acc1.SetCoins(sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, amt)))
+	happ.AccountKeeper.SetAccount(ctx, acc1)
+
+	// fees are charged per msg
+	msgs := []sdk.Msg{sdkAuth.NewTestMsg(addr1), sdkAuth.NewTestMsg(addr1)}
+	tx := types.NewTestBatchTx(ctx, msgs, priv1, uint64(0), uint64(0))
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInsufficientFunds)
+
+	acc1 = happ.AccountKeeper.GetAccount(ctx, hmTypes.AccAddressToHeimdallAddress(addr1))
+	acc1.SetCoins(sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, amt.MulRaw(2))))
+	happ.AccountKeeper.SetAccount(ctx, acc1)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	require.True(sdk.IntEq(t, happ.SupplyKeeper.GetModuleAccount(ctx, types.FeeCollectorName).GetCoins().AmountOf(authTypes.FeeToken), amt.MulRaw(2)))
+	require.True(sdk.IntEq(t, happ.AccountKeeper.GetAccount(ctx, hmTypes.AccAddressToHeimdallAddress(addr1)).GetCoins().AmountOf(authTypes.FeeToken), sdk.NewInt(0)))
+
+	// batched msgs must have single signer
+	_, _, addr2 := sdkAuth.KeyTestPubAddr()
+	msgs = []sdk.Msg{sdkAuth.NewTestMsg(addr1), sdkAuth.NewTestMsg(addr2)}
+	tx = types.NewTestBatchTx(ctx, msgs, priv1, uint64(0), uint64(1))
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// too many msgs are rejected before charging gas and fees
+	msgs = make([]sdk.Msg, authTypes.MaxMsgsPerTx+1)
+	for i := range msgs {
+		msgs[i] = sdkAuth.NewTestMsg(addr1)
+	}
+	tx = types.NewTestBatchTx(ctx, msgs, priv1, uint64(0), uint64(1))
+	newCtx, _, _ := checkInvalidTx(t, anteHandler, ctx.WithBlockHeight(1), tx, false, sdk.CodeUnknownRequest)
+	require.Equal(t, uint64(0), newCtx.GasMeter().Limit())
+}
+
 //
 // utils
 //
@@ -19,17 +19,32 @@ type StdSignDoc struct {
 	Sequence      uint64          `json:"sequence" yaml:"sequence"`
 	Msg           json.RawMessage `json:"msg" yaml:"msg"`
 	Memo          string          `json:"memo" yaml:"memo"`
+
+	// omitted for single msg tx, so that its sign bytes are unchanged
+	ExtraMsgs []json.RawMessage `json:"extra_msgs,omitempty" yaml:"extra_msgs,omitempty"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, accnum uint64, sequence uint64, msg sdk.Msg, memo string) []byte {
-	msgsBytes := json.RawMessage(msg.GetSignBytes())
+	return StdBatchSignBytes(chainID, accnum, sequence, []sdk.Msg{msg}, memo)
+}
+
+// StdBatchSignBytes returns the bytes to sign for a transaction with multiple msgs.
+func StdBatchSignBytes(chainID string, accnum uint64, sequence uint64, msgs []sdk.Msg, memo string) []byte {
+	msgsBytes := json.RawMessage(msgs[0].GetSignBytes())
+
+	var extraMsgsBytes []json.RawMessage
+	for _, msg := range msgs[1:] {
+		extraMsgsBytes = append(extraMsgsBytes, json.RawMessage(msg.GetSignBytes()))
+	}
+
 	bz, err := ModuleCdc.MarshalJSON(StdSignDoc{
 		AccountNumber: accnum,
 		ChainID:       chainID,
 		Memo:          memo,
 		Msg:           msgsBytes,
 		Sequence:      sequence,
+		ExtraMsgs:     extraMsgsBytes,
 	})
 	if err != nil {
 		panic(err)
@@ -46,9 +61,16 @@ type StdSignMsg struct {
 	Sequence      uint64  `json:"sequence" yaml:"sequence"`
 	Msg           sdk.Msg `json:"msg" yaml:"msg"`
 	Memo          string  `json:"memo" yaml:"memo"`
+
+	ExtraMsgs []sdk.Msg `json:"extra_msgs,omitempty" yaml:"extra_msgs,omitempty"`
+}
+
+// Msgs returns all msgs to be signed
+func (msg StdSignMsg) Msgs() []sdk.Msg {
+	return append([]sdk.Msg{msg.Msg}, msg.ExtraMsgs...)
 }
 
 // Bytes returns message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Msg, msg.Memo)
+	return StdBatchSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Msgs(), msg.Memo)
 }
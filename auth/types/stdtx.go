@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,11 +17,17 @@ var (
 	maxGasWanted = uint64((1 << 63) - 1)
 )
 
+// MaxMsgsPerTx is maximum number of msgs batched in single tx
+const MaxMsgsPerTx = 20
+
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
 type StdTx struct {
 	Msg       sdk.Msg      `json:"msg" yaml:"msg"`
 	Signature StdSignature `json:"signature" yaml:"signature"`
 	Memo      string       `json:"memo" yaml:"memo"`
+
+	// ExtraMsgs are msgs batched after Msg (not part of RLP encoding, so not allowed with checkpoint)
+	ExtraMsgs []sdk.Msg `json:"extra_msgs,omitempty" yaml:"extra_msgs,omitempty" rlp:"-"`
 }
 
 // StdTxRaw is a standard way to wrap a RLP Msg with Fee and Signatures.
@@ -39,17 +46,46 @@ func NewStdTx(msg sdk.Msg, sig StdSignature, memo string) StdTx {
 	}
 }
 
+// NewBatchStdTx creates std tx with multiple msgs
+func NewBatchStdTx(msgs []sdk.Msg, sig StdSignature, memo string) StdTx {
+	tx := NewStdTx(msgs[0], sig, memo)
+	if len(msgs) > 1 {
+		tx.ExtraMsgs = msgs[1:]
+	}
+	return tx
+}
+
 // GetMsgs returns the all the transaction's messages.
 func (tx StdTx) GetMsgs() []sdk.Msg {
-	return []sdk.Msg{tx.Msg}
+	return append([]sdk.Msg{tx.Msg}, tx.ExtraMsgs...)
 }
 
 // ValidateBasic does a simple and lightweight validation check that doesn't
 // require access to any other information.
 func (tx StdTx) ValidateBasic() sdk.Error {
+	if len(tx.ExtraMsgs) == 0 {
+		return nil
+	}
+
+	if len(tx.ExtraMsgs)+1 > MaxMsgsPerTx {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("too many msgs in tx; max %d", MaxMsgsPerTx))
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if !IsBatchableMsg(msg) {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("msg %s/%s can't be batched", msg.Route(), msg.Type()))
+		}
+	}
+
 	return nil
 }
 
+// IsBatchableMsg checks if msg can be sent with other msgs in same tx.
+// Checkpoint tx is RLP encoded and submitted to rootchain, so it must have single msg.
+func IsBatchableMsg(msg sdk.Msg) bool {
+	return !(msg.Type() == "checkpoint" && msg.Route() == "checkpoint")
+}
+
 // GetSigners returns the addresses that must sign the transaction.
 // Addresses are returned in a deterministic order.
 // They are accumulated from the GetSigners method for each Msg
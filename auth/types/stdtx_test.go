@@ -61,3 +61,47 @@ func TestDefaultTxEncoder(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, cdcBytes, encoderBytes)
 }
+
+func TestBatchStdTx(t *testing.T) {
+	msg1 := sdk.NewTestMsg(addr)
+	msg2 := sdk.NewTestMsg(addr)
+
+	// single msg sign bytes don't change
+	require.NotContains(t, string(StdSignBytes("mychainid", 0, 0, msg1, "")), "extra_msgs")
+	require.Contains(t, string(StdBatchSignBytes("mychainid", 0, 0, []sdk.Msg{msg1, msg2}, "")), "extra_msgs")
+
+	tx := NewBatchStdTx([]sdk.Msg{msg1, msg2}, StdSignature{}, "")
+	require.Len(t, tx.GetMsgs(), 2)
+	require.Len(t, tx.GetSigners(), 1)
+	require.NoError(t, tx.ValidateBasic())
+
+	// encode and decode
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+
+	txBytes, err := DefaultTxEncoder(cdc)(tx)
+	require.NoError(t, err)
+	decoded, sdkErr := DefaultTxDecoder(cdc)(txBytes)
+	require.Nil(t, sdkErr)
+	require.Len(t, decoded.GetMsgs(), 2)
+
+	// checkpoint can't be batched
+	tx = NewBatchStdTx([]sdk.Msg{msg1, &testCheckpointMsg{*msg2}}, StdSignature{}, "")
+	require.Error(t, tx.ValidateBasic())
+
+	// too many msgs
+	msgs := make([]sdk.Msg, MaxMsgsPerTx+1)
+	for i := range msgs {
+		msgs[i] = msg1
+	}
+	require.Error(t, NewBatchStdTx(msgs, StdSignature{}, "").ValidateBasic())
+}
+
+type testCheckpointMsg struct {
+	sdk.TestMsg
+}
+
+func (msg *testCheckpointMsg) Route() string { return "checkpoint" }
+func (msg *testCheckpointMsg) Type() string  { return "checkpoint" }
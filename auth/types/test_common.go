@@ -40,3 +40,14 @@ func NewTestTxWithSignBytes(msg sdk.Msg, priv crypto.PrivKey, accNum uint64, seq
 	tx := NewStdTx(msg, sig, memo)
 	return tx
 }
+
+// NewTestBatchTx creates new test tx with multiple msgs
+func NewTestBatchTx(ctx sdk.Context, msgs []sdk.Msg, priv crypto.PrivKey, accNum uint64, seq uint64) sdk.Tx {
+	signBytes := StdBatchSignBytes(ctx.ChainID(), accNum, seq, msgs, "")
+	sig, err := priv.Sign(signBytes)
+	if err != nil {
+		panic(err)
+	}
+
+	return NewBatchStdTx(msgs, sig, "")
+}
@@ -185,13 +185,18 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		return StdSignMsg{}, fmt.Errorf("chain ID required but not specified")
 	}
 
-	return StdSignMsg{
+	signMsg := StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
-		Msg:           msgs[0], // allow only one message
-	}, nil
+		Msg:           msgs[0],
+	}
+	if len(msgs) > 1 {
+		signMsg.ExtraMsgs = msgs[1:]
+	}
+
+	return signMsg, nil
 }
 
 // Sign transaction with default node key
@@ -201,7 +206,7 @@ func (bldr TxBuilder) Sign(privKey secp256k1.PrivKeySecp256k1, msg StdSignMsg) (
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msg, sig, msg.Memo))
+	return bldr.txEncoder(NewBatchStdTx(msg.Msgs(), sig, msg.Memo))
 }
 
 // SignWithPassphrase signs a transaction given a name, passphrase, and a single message to
@@ -212,7 +217,7 @@ func (bldr TxBuilder) SignWithPassphrase(name, passphrase string, msg StdSignMsg
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msg, sig, msg.Memo))
+	return bldr.txEncoder(NewBatchStdTx(msg.Msgs(), sig, msg.Memo))
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -247,7 +252,7 @@ func (bldr TxBuilder) BuildTxForSim(msgs []sdk.Msg) ([]byte, error) {
 
 	// the ante handler will populate with a sentinel pubkey
 	sig := StdSignature{}
-	return bldr.txEncoder(NewStdTx(signMsg.Msg, sig, signMsg.Memo))
+	return bldr.txEncoder(NewBatchStdTx(signMsg.Msgs(), sig, signMsg.Memo))
 }
 
 // SignStdTxWithPassphrase appends a signature to a StdTx and returns a copy of it. If append
@@ -261,14 +266,15 @@ func (bldr TxBuilder) SignStdTxWithPassphrase(name, passphrase string, stdTx Std
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
-		Msg:           stdTx.GetMsgs()[0],
+		Msg:           stdTx.Msg,
 		Memo:          stdTx.GetMemo(),
+		ExtraMsgs:     stdTx.ExtraMsgs,
 	})
 	if err != nil {
 		return
 	}
 
-	signedStdTx = NewStdTx(stdTx.GetMsgs()[0], stdSignature, stdTx.GetMemo())
+	signedStdTx = NewBatchStdTx(stdTx.GetMsgs(), stdSignature, stdTx.GetMemo())
 	return
 }
 
@@ -284,7 +290,8 @@ func (bldr TxBuilder) SignStdTx(privKey secp256k1.PrivKeySecp256k1, stdTx StdTx,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
 		Memo:          stdTx.Memo,
-		Msg:           stdTx.Msg, // allow only one message
+		Msg:           stdTx.Msg,
+		ExtraMsgs:     stdTx.ExtraMsgs,
 	}
 
 	sig, err := MakeSignature(privKey, signMsg)
@@ -292,7 +299,7 @@ func (bldr TxBuilder) SignStdTx(privKey secp256k1.PrivKeySecp256k1, stdTx StdTx,
 		return
 	}
 
-	signedStdTx = NewStdTx(signMsg.Msg, sig, signMsg.Memo)
+	signedStdTx = NewBatchStdTx(signMsg.Msgs(), sig, signMsg.Memo)
 	return
 }
 
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// deadLetterCmd groups commands to manage tasks which failed after all retries
+var deadLetterCmd = &cobra.Command{
+	Use:   "dead-letter",
+	Short: "Manage failed bridge tasks (bridge must be stopped)",
+}
+
+var deadLetterListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List dead-lettered tasks",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		deadLetterStore, err := getDeadLetterStore()
+		if err != nil {
+			return err
+		}
+
+		deadLetters, err := deadLetterStore.List()
+		if err != nil {
+			return err
+		}
+
+		for _, deadLetter := range deadLetters {
+			fmt.Printf("%v\t%v\tattempts=%v\tfailedAt=%v\terror=%v\n",
+				deadLetter.UUID,
+				deadLetter.Signature.Name,
+				deadLetter.Attempts,
+				deadLetter.FailedAt.Format("2006-01-02T15:04:05Z"),
+				deadLetter.Error,
+			)
+		}
+
+		fmt.Printf("Total dead-lettered tasks: %v\n", len(deadLetters))
+		return nil
+	},
+}
+
+var deadLetterInspectCmd = &cobra.Command{
+	Use:   "inspect [uuid]",
+	Short: "Show dead-lettered task with its arguments",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		deadLetterStore, err := getDeadLetterStore()
+		if err != nil {
+			return err
+		}
+
+		deadLetter, err := deadLetterStore.Get(args[0])
+		if err != nil {
+			return err
+		}
+
+		out, err := json.MarshalIndent(deadLetter, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(out))
+		return nil
+	},
+}
+
+var deadLetterReplayCmd = &cobra.Command{
+	Use:   "replay [uuid]",
+	Short: "Send dead-lettered task to the queue again",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		deadLetters, err := getDeadLetterStore()
+		if err != nil {
+			return err
+		}
+
+		queueBackend, err := queue.NewBackend(helper.GetConfig())
+		if err != nil {
+			return err
+		}
+
+		if err := deadLetters.Replay(args[0], queue.NewQueueConnector(queueBackend, deadLetters)); err != nil {
+			return err
+		}
+
+		fmt.Printf("Replayed task %v\n", args[0])
+		return nil
+	},
+}
+
+var deadLetterDiscardCmd = &cobra.Command{
+	Use:   "discard [uuid]",
+	Short: "Remove dead-lettered task",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		deadLetterStore, err := getDeadLetterStore()
+		if err != nil {
+			return err
+		}
+
+		if err := deadLetterStore.Discard(args[0]); err != nil {
+			return err
+		}
+
+		fmt.Printf("Discarded task %v\n", args[0])
+		return nil
+	},
+}
+
+func getDeadLetterStore() (*queue.DeadLetterStore, error) {
+	db, err := getBridgeDB()
+	if err != nil {
+		return nil, err
+	}
+
+	return queue.NewDeadLetterStore(db), nil
+}
+
+func init() {
+	deadLetterCmd.AddCommand(
+		deadLetterListCmd,
+		deadLetterInspectCmd,
+		deadLetterReplayCmd,
+		deadLetterDiscardCmd,
+	)
+	rootCmd.AddCommand(deadLetterCmd)
+}
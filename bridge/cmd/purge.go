@@ -1,43 +1,39 @@
 package cmd
 
 import (
-	"github.com/maticnetwork/heimdall/helper"
-	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"fmt"
+
 	"github.com/spf13/cobra"
-	"github.com/streadway/amqp"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/helper"
 )
 
 // purgeCmd represents the reset of queue
 var purgeCmd = &cobra.Command{
 	Use:   "purge-queue",
 	Short: "Reset bridge queue tasks",
-	Run: func(cmd *cobra.Command, args []string) {		
+	Long:  "Reset bridge queue tasks. Bridge must be stopped when using the leveldb queue backend.",
+	RunE: func(cmd *cobra.Command, args []string) error {
 		// purge Queue
-		purgeQueue()
+		return purgeQueue()
 	},
 }
 
-
-func purgeQueue() {	
-	dialer := helper.GetConfig().AmqpURL
-
-	// amqp dialer
-	conn, err := amqp.Dial(dialer)
+func purgeQueue() error {
+	queueBackend, err := queue.NewBackend(helper.GetConfig())
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	// initialize exchange
-	channel, err := conn.Channel()
-	if err != nil {
-		panic(err)
+	if err := queueBackend.Purge(); err != nil {
+		return err
 	}
 
-	channel.QueuePurge(queue.QueueName, false)
+	fmt.Printf("Purged bridge queue (backend: %v)\n", queueBackend.String())
+	return nil
 }
 
-
-
 func init() {
 	rootCmd.AddCommand(purgeCmd)
 }
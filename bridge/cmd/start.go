@@ -1,9 +1,11 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -19,6 +21,7 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
 	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/processor"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
@@ -28,6 +31,7 @@ import (
 const (
 	waitDuration = 1 * time.Minute
 	logLevel     = "log_level"
+	metricsAddr  = "metrics-addr"
 )
 
 // GetStartCmd returns the start command to start bridge
@@ -41,19 +45,68 @@ func GetStartCmd() *cobra.Command {
 			// create codec
 			cdc := app.MakeCodec()
 			app.MakePulp()
-			// queue connector & http client
-			_queueConnector := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
-			_queueConnector.StartWorker()
+			// selected listeners and processors
+			if err := validateSelectedServices(); err != nil {
+				panic(err)
+			}
+			runListeners := listener.IsAnyListenerSelected()
+			runProcessors := util.IsAnyServiceSelected(processor.ProcessorNames, util.ProcessorsServiceGroup)
+			if !runListeners && !runProcessors {
+				panic("No services selected. Use --all or --only <comma separated listeners/processors>")
+			}
+
+			// leveldb queue lives in bridge db of single process, listeners and processors can't be split
+			if helper.GetConfig().QueueBackend == helper.LevelDBQueueBackend && runListeners != runProcessors {
+				panic("Listener-only or processor-only mode requires shared queue backend, leveldb queue backend is local to bridge process")
+			}
+
+			// queue backend
+			queueBackend, err := queue.NewBackend(helper.GetConfig())
+			if err != nil {
+				panic(fmt.Sprintf("Error creating queue backend %v", err))
+			}
 
-			_txBroadcaster := broadcaster.NewTxBroadcaster(cdc)
+			// queue connector & http client
+			deadLetters := queue.NewDeadLetterStore(util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)))
+			_queueConnector := queue.NewQueueConnector(queueBackend, deadLetters)
+			metrics.RegisterQueueSize(func() float64 {
+				size, _ := _queueConnector.QueueSize()
+				return float64(size)
+			})
 			_httpClient := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
 
 			// selected services to start
 			services := []common.Service{}
-			services = append(services,
-				listener.NewListenerService(cdc, _queueConnector),
-				processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster),
-			)
+
+			// listeners only enqueue tasks
+			if runListeners {
+				services = append(services, listener.NewListenerService(cdc, _queueConnector))
+			}
+
+			// processors consume tasks and broadcast txs
+			if runProcessors {
+				_queueConnector.StartWorker()
+
+				_txBroadcaster := broadcaster.NewTxBroadcaster(cdc)
+				services = append(services, processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster))
+				for _, nonceManager := range _txBroadcaster.NonceManagers() {
+					services = append(services, nonceManager)
+				}
+			}
+
+			// cli context
+			cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
+			cliCtx.BroadcastMode = client.BroadcastAsync
+			cliCtx.TrustNode = true
+
+			// health and metrics server
+			metricsServer := metrics.NewServer(logger, viper.GetString(metricsAddr), func() error {
+				if util.IsCatchingUp(cliCtx) {
+					return errors.New("heimdall node is catching up")
+				}
+				return nil
+			})
+			metricsServer.Start()
 
 			// sync group
 			var wg sync.WaitGroup
@@ -73,6 +126,9 @@ func GetStartCmd() *cobra.Command {
 					// stop http client
 					_httpClient.Stop()
 
+					// stop health and metrics server
+					metricsServer.Stop()
+
 					// stop db instance
 					util.CloseBridgeDBInstance()
 
@@ -82,16 +138,11 @@ func GetStartCmd() *cobra.Command {
 			}()
 
 			// Start http client
-			err := _httpClient.Start()
+			err = _httpClient.Start()
 			if err != nil {
 				panic(fmt.Sprintf("Error connecting to server %v", err))
 			}
 
-			// cli context
-			cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
-			cliCtx.BroadcastMode = client.BroadcastAsync
-			cliCtx.TrustNode = true
-
 			// start bridge services only when node fully synced
 			for {
 				if !util.IsCatchingUp(cliCtx) {
@@ -112,6 +163,8 @@ func GetStartCmd() *cobra.Command {
 					<-serv.Quit()
 				}(service)
 			}
+			metricsServer.SetReady(true)
+
 			// wait for all processes
 			wg.Add(len(services))
 			wg.Wait()
@@ -121,14 +174,46 @@ func GetStartCmd() *cobra.Command {
 	startCmd.Flags().String(logLevel, "info", "Log level for bridge")
 	viper.BindPFlag(logLevel, startCmd.Flags().Lookup(logLevel))
 
-	startCmd.Flags().Bool("all", false, "start all bridge services")
-	viper.BindPFlag("all", startCmd.Flags().Lookup("all"))
-
-	startCmd.Flags().StringSlice("only", []string{}, "comma separated bridge services to start")
-	viper.BindPFlag("only", startCmd.Flags().Lookup("only"))
+	// health and metrics server
+	startCmd.Flags().String(metricsAddr, metrics.DefaultServerAddr, "Listen address for bridge health and prometheus metrics server")
+	viper.BindPFlag(metricsAddr, startCmd.Flags().Lookup(metricsAddr))
+
+	startCmd.Flags().Bool(util.AllServicesFlag, false, "start all bridge listeners and processors")
+	viper.BindPFlag(util.AllServicesFlag, startCmd.Flags().Lookup(util.AllServicesFlag))
+
+	startCmd.Flags().StringSlice(util.OnlyServicesFlag, []string{}, fmt.Sprintf(
+		"comma separated bridge listeners (%v) and processors (%v) to start, or %v/%v to start all of them. Processors also start listeners feeding them",
+		strings.Join(listener.ListenerNames, ","),
+		strings.Join(processor.ProcessorNames, ","),
+		util.ListenersServiceGroup,
+		util.ProcessorsServiceGroup,
+	))
+	viper.BindPFlag(util.OnlyServicesFlag, startCmd.Flags().Lookup(util.OnlyServicesFlag))
 	return startCmd
 }
 
+// validateSelectedServices checks that services passed with --only flag exist
+func validateSelectedServices() error {
+	known := map[string]bool{
+		util.ListenersServiceGroup:  true,
+		util.ProcessorsServiceGroup: true,
+	}
+	for _, name := range listener.ListenerNames {
+		known[name] = true
+	}
+	for _, name := range processor.ProcessorNames {
+		known[name] = true
+	}
+
+	for _, service := range viper.GetStringSlice(util.OnlyServicesFlag) {
+		if !known[service] {
+			return fmt.Errorf("Unknown bridge service %v", service)
+		}
+	}
+
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(GetStartCmd())
 }
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// statusCmd prints bridge progress stored in bridge db
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show last processed blocks, queue size and proposer position (bridge must be stopped)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db, err := getBridgeDB()
+		if err != nil {
+			return err
+		}
+
+		for _, name := range listenerNames() {
+			lastBlock, ok, err := getLastBlock(db, name)
+			if err != nil {
+				return err
+			}
+
+			if ok {
+				fmt.Printf("%v last block: %v\n", name, lastBlock)
+			} else {
+				fmt.Printf("%v last block: not set\n", name)
+			}
+		}
+
+		deadLetters, err := queue.NewDeadLetterStore(db).List()
+		if err != nil {
+			return err
+		}
+
+		if queueBackend, err := queue.NewBackend(helper.GetConfig()); err != nil {
+			fmt.Printf("Queue size: unavailable (%v)\n", err)
+		} else if size, err := queue.NewQueueConnector(queueBackend, nil).QueueSize(); err != nil {
+			fmt.Printf("Queue size: unavailable (%v)\n", err)
+		} else {
+			fmt.Printf("Queue size: %v (backend: %v)\n", size, queueBackend.String())
+		}
+		fmt.Printf("Dead-lettered tasks: %v\n", len(deadLetters))
+
+		// proposer query is capped at validator set size, so this fetches all upcoming proposers
+		cliCtx := cliContext.NewCLIContext().WithCodec(app.MakeCodec())
+		position, err := util.GetProposerPosition(cliCtx, math.MaxInt64)
+		switch {
+		case err != nil:
+			fmt.Printf("Proposer position: unavailable (%v)\n", err)
+		case position == 0:
+			fmt.Println("Proposer position: not in proposer list")
+		default:
+			fmt.Printf("Proposer position: %v\n", position)
+		}
+
+		return nil
+	},
+}
+
+// setLastBlockCmd sets last processed block of a listener, so that the following blocks are scanned again
+var setLastBlockCmd = &cobra.Command{
+	Use:   "set-last-block [listener] [block]",
+	Short: "Set last processed block for listener (bridge must be stopped)",
+	Long:  "Set last processed block for listener (rootchain or heimdall). Listener re-scans blocks after the given block on next start.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		block, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		return updateLastBlock(args[0], func(uint64, bool) (uint64, error) {
+			return block, nil
+		})
+	},
+}
+
+// rewindLastBlockCmd moves last processed block of a listener back by given number of blocks
+var rewindLastBlockCmd = &cobra.Command{
+	Use:   "rewind-last-block [listener] [blocks]",
+	Short: "Rewind last processed block for listener (bridge must be stopped)",
+	Long:  "Rewind last processed block for listener (rootchain or heimdall) by given number of blocks. Listener re-scans those blocks on next start.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		blocks, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		return updateLastBlock(args[0], func(lastBlock uint64, ok bool) (uint64, error) {
+			if !ok {
+				return 0, fmt.Errorf("Last block is not set for listener %v", args[0])
+			}
+
+			if blocks > lastBlock {
+				return 0, nil
+			}
+
+			return lastBlock - blocks, nil
+		})
+	},
+}
+
+// updateLastBlock stores last block computed from the current one
+func updateLastBlock(name string, update func(lastBlock uint64, ok bool) (uint64, error)) error {
+	key, ok := listener.LastBlockKeys[name]
+	if !ok {
+		return fmt.Errorf("Invalid listener %v, must be one of %v", name, listenerNames())
+	}
+
+	db, err := getBridgeDB()
+	if err != nil {
+		return err
+	}
+
+	lastBlock, ok, err := getLastBlock(db, name)
+	if err != nil {
+		return err
+	}
+
+	newLastBlock, err := update(lastBlock, ok)
+	if err != nil {
+		return err
+	}
+
+	if err := db.Put([]byte(key), []byte(strconv.FormatUint(newLastBlock, 10)), nil); err != nil {
+		return err
+	}
+
+	fmt.Printf("Updated %v last block: %v -> %v\n", name, lastBlock, newLastBlock)
+	return nil
+}
+
+// getLastBlock returns stored last block of the listener
+func getLastBlock(db *leveldb.DB, name string) (uint64, bool, error) {
+	value, err := db.Get([]byte(listener.LastBlockKeys[name]), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+
+	lastBlock, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return lastBlock, true, nil
+}
+
+func listenerNames() []string {
+	names := make([]string, 0, len(listener.LastBlockKeys))
+	for name := range listener.LastBlockKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func getBridgeDB() (*leveldb.DB, error) {
+	db := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+	if db == nil {
+		return nil, errors.New("Unable to open bridge db, make sure bridge is stopped")
+	}
+
+	return db, nil
+}
+
+func init() {
+	rootCmd.AddCommand(
+		statusCmd,
+		setLastBlockCmd,
+		rewindLastBlockCmd,
+	)
+}
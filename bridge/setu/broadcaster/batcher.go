@@ -0,0 +1,74 @@
+package broadcaster
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// batchRequest is msg waiting to be sent in a batch
+type batchRequest struct {
+	msg    sdk.Msg
+	result chan error
+}
+
+// msgBatcher collects msgs for a short window (or up to max count) and sends them together
+type msgBatcher struct {
+	maxSize  int
+	window   time.Duration
+	requests chan batchRequest
+
+	send func(msgs []sdk.Msg) error
+}
+
+// newMsgBatcher creates batcher and starts collecting msgs
+func newMsgBatcher(maxSize int, window time.Duration, send func(msgs []sdk.Msg) error) *msgBatcher {
+	b := &msgBatcher{
+		maxSize:  maxSize,
+		window:   window,
+		requests: make(chan batchRequest, maxSize),
+		send:     send,
+	}
+	go b.run()
+	return b
+}
+
+// Add adds msg to next batch and waits until the batch is sent
+func (b *msgBatcher) Add(msg sdk.Msg) error {
+	req := batchRequest{
+		msg:    msg,
+		result: make(chan error, 1),
+	}
+	b.requests <- req
+	return <-req.result
+}
+
+func (b *msgBatcher) run() {
+	for req := range b.requests {
+		batch := []batchRequest{req}
+
+		// wait for more msgs, starting from first msg of the batch
+		timer := time.NewTimer(b.window)
+	collect:
+		for len(batch) < b.maxSize {
+			select {
+			case req := <-b.requests:
+				batch = append(batch, req)
+			case <-timer.C:
+				break collect
+			}
+		}
+		timer.Stop()
+
+		msgs := make([]sdk.Msg, len(batch))
+		for i, req := range batch {
+			msgs[i] = req.msg
+		}
+
+		// all msgs in the batch share the result, failed ones are retried by their tasks
+		err := b.send(msgs)
+		for _, req := range batch {
+			req.result <- err
+		}
+	}
+}
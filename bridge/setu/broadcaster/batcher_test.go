@@ -0,0 +1,51 @@
+package broadcaster
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBatcher(t *testing.T) {
+	var mutex sync.Mutex
+	var batches [][]sdk.Msg
+	batcher := newMsgBatcher(3, 200*time.Millisecond, func(msgs []sdk.Msg) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		batches = append(batches, msgs)
+		if len(msgs) == 1 {
+			return errors.New("broadcast error")
+		}
+		return nil
+	})
+
+	// 4 msgs are sent in full batch of 3 and remaining one after window
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- batcher.Add(sdk.NewTestMsg())
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var failed int
+	for err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+
+	require.Len(t, batches, 2)
+	require.Len(t, batches[0], 3)
+	require.Len(t, batches[1], 1)
+	// result of the batch is returned to each msg
+	require.Equal(t, 1, failed)
+}
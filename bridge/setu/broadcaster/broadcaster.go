@@ -3,6 +3,7 @@ package broadcaster
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,10 +11,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bor "github.com/maticnetwork/bor"
-	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	ethTypes "github.com/maticnetwork/bor/core/types"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
+	"github.com/spf13/viper"
 
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -25,11 +29,19 @@ type TxBroadcaster struct {
 
 	cliCtx cliContext.CLIContext
 
-	heimdallMutex sync.Mutex
-	maticMutex    sync.Mutex
+	heimdallMutex  sync.Mutex
+	maticMutex     sync.Mutex
+	rootchainMutex sync.Mutex
 
 	lastSeqNo uint64
 	accNum    uint64
+
+	// batches heimdall msgs, nil if batching is disabled
+	heimdallBatcher *msgBatcher
+
+	// track nonces and stuck transactions on matic chain and rootchain
+	maticNonceManager     *NonceManager
+	rootchainNonceManager *NonceManager
 }
 
 // NewTxBroadcaster creates new broadcaster
@@ -46,11 +58,27 @@ func NewTxBroadcaster(cdc *codec.Codec) *TxBroadcaster {
 
 	}
 
+	// bridge db and signer for tracked transactions
+	db := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+	auth := bind.NewKeyedTransactor(helper.GetECDSAPrivKey())
+
 	txBroadcaster := TxBroadcaster{
-		logger:    util.Logger().With("module", "txBroadcaster"),
-		cliCtx:    cliCtx,
-		lastSeqNo: account.GetSequence(),
-		accNum:    account.GetAccountNumber(),
+		logger:            util.Logger().With("module", "txBroadcaster"),
+		cliCtx:            cliCtx,
+		lastSeqNo:         account.GetSequence(),
+		accNum:            account.GetAccountNumber(),
+		maticNonceManager: NewNonceManager(metrics.MaticChain, helper.GetMaticClient(), db, auth),
+		rootchainNonceManager: NewNonceManager(metrics.RootChain, helper.GetMainClient(), db, auth).
+			WithMaxGasPrice(big.NewInt(helper.GetConfig().MainchainMaxGasPrice)),
+	}
+	metrics.AccountSequence.Set(float64(txBroadcaster.lastSeqNo))
+
+	// heimdall msg batching
+	if batchSize := helper.GetConfig().HeimdallBatchSize; batchSize > 1 {
+		if batchSize > authTypes.MaxMsgsPerTx {
+			batchSize = authTypes.MaxMsgsPerTx
+		}
+		txBroadcaster.heimdallBatcher = newMsgBatcher(batchSize, helper.GetConfig().HeimdallBatchWindow, txBroadcaster.broadcastMsgsToHeimdall)
 	}
 
 	return &txBroadcaster
@@ -58,6 +86,15 @@ func NewTxBroadcaster(cdc *codec.Codec) *TxBroadcaster {
 
 // BroadcastToHeimdall broadcast to heimdall
 func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
+	if tb.heimdallBatcher != nil && authTypes.IsBatchableMsg(msg) {
+		return tb.heimdallBatcher.Add(msg)
+	}
+
+	return tb.broadcastMsgsToHeimdall([]sdk.Msg{msg})
+}
+
+// broadcastMsgsToHeimdall broadcasts msgs in single tx to heimdall
+func (tb *TxBroadcaster) broadcastMsgsToHeimdall(msgs []sdk.Msg) error {
 	tb.heimdallMutex.Lock()
 	defer tb.heimdallMutex.Unlock()
 
@@ -73,9 +110,10 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
 		WithSequence(tb.lastSeqNo).
 		WithChainID(chainID)
 
-	txResponse, err := helper.BuildAndBroadcastMsgs(tb.cliCtx, txBldr, []sdk.Msg{msg})
+	txResponse, err := helper.BuildAndBroadcastMsgs(tb.cliCtx, txBldr, msgs)
 	if err != nil {
 		tb.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
+		metrics.BroadcastFailure.WithLabelValues(metrics.HeimdallChain).Inc()
 
 		// current address
 		address := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
@@ -89,14 +127,17 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
 
 		// update seqNo for safety
 		tb.lastSeqNo = account.GetSequence()
+		metrics.AccountSequence.Set(float64(tb.lastSeqNo))
 
 		return err
 	}
 
-	tb.logger.Info("Tx sent on heimdall", "txHash", txResponse.TxHash, "accSeq", tb.lastSeqNo, "accNum", tb.accNum)
+	tb.logger.Info("Tx sent on heimdall", "txHash", txResponse.TxHash, "msgs", len(msgs), "accSeq", tb.lastSeqNo, "accNum", tb.accNum)
 	tb.logger.Debug("Tx successful on heimdall", "txResponse", txResponse)
 	// increment account sequence
 	tb.lastSeqNo += 1
+	metrics.BroadcastSuccess.WithLabelValues(metrics.HeimdallChain).Inc()
+	metrics.AccountSequence.Set(float64(tb.lastSeqNo))
 	return nil
 }
 
@@ -116,26 +157,85 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 		return err
 	}
 
-	// Create the transaction, sign it and schedule it for execution
-	rawTx := types.NewTransaction(auth.Nonce.Uint64(), *msg.To, msg.Value, auth.GasLimit, auth.GasPrice, msg.Data)
-
-	// signer
-	signedTx, err := auth.Signer(types.HomesteadSigner{}, auth.From, rawTx)
+	// sign and send transaction with next nonce, it's tracked until mined
+	signedTx, err := tb.maticNonceManager.Send(context.Background(), *msg.To, msg.Value, auth.GasLimit, auth.GasPrice, msg.Data)
 	if err != nil {
-		tb.logger.Error("Error signing the transaction", "error", err)
-		return err
-	}
-
-	tb.logger.Info("Sending transaction to bor", "txHash", signedTx.Hash())
-
-	// broadcast transaction
-	if err := maticClient.SendTransaction(context.Background(), signedTx); err != nil {
 		tb.logger.Error("Error while broadcasting the transaction to maticchain", "error", err)
+		metrics.BroadcastFailure.WithLabelValues(metrics.MaticChain).Inc()
 		return err
 	}
 
+	tb.logger.Info("Sent transaction to bor", "txHash", signedTx.Hash())
+	metrics.BroadcastSuccess.WithLabelValues(metrics.MaticChain).Inc()
+
 	return nil
 }
 
+// NonceManagers returns nonce managers which need to be started with bridge services
+func (tb *TxBroadcaster) NonceManagers() []*NonceManager {
+	return []*NonceManager{tb.maticNonceManager, tb.rootchainNonceManager}
+}
+
 // BroadcastToRootchain broadcast to rootchain
-func (tb *TxBroadcaster) BroadcastToRootchain() {}
+func (tb *TxBroadcaster) BroadcastToRootchain(msg bor.CallMsg) error {
+	_, err := tb.sendToRootchain(msg)
+	return err
+}
+
+// BroadcastToRootchainAndWait broadcasts to rootchain and waits until transaction is mined,
+// error is returned if it is dropped or reverted
+func (tb *TxBroadcaster) BroadcastToRootchainAndWait(ctx context.Context, msg bor.CallMsg) (*ethTypes.Receipt, error) {
+	signedTx, err := tb.sendToRootchain(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := tb.rootchainNonceManager.WaitMined(ctx, signedTx.Nonce(), signedTx.Hash())
+	if err != nil {
+		tb.logger.Error("Error while waiting for rootchain transaction", "txHash", signedTx.Hash(), "error", err)
+		return nil, err
+	}
+
+	if receipt.Status == ethTypes.ReceiptStatusFailed {
+		tb.logger.Error("Rootchain transaction failed", "txHash", receipt.TxHash)
+		return receipt, fmt.Errorf("Rootchain transaction %v failed", receipt.TxHash.Hex())
+	}
+
+	tb.logger.Info("Rootchain transaction mined", "txHash", receipt.TxHash, "block", receipt.BlockNumber)
+	return receipt, nil
+}
+
+// sendToRootchain signs and sends transaction to rootchain
+func (tb *TxBroadcaster) sendToRootchain(msg bor.CallMsg) (*ethTypes.Transaction, error) {
+	tb.rootchainMutex.Lock()
+	defer tb.rootchainMutex.Unlock()
+
+	// get main client
+	mainClient := helper.GetMainClient()
+
+	// get auth
+	auth, err := helper.GenerateAuthObj(mainClient, *msg.To, msg.Data)
+	if err != nil {
+		if auth == nil {
+			tb.logger.Error("Error generating auth object", "error", err)
+			return nil, err
+		}
+
+		// gas estimation failed
+		tb.logger.Error("Unable to estimate gas, setting custom gaslimit", "gaslimit", helper.GetConfig().MainchainGasLimit, "error", err)
+		auth.GasLimit = helper.GetConfig().MainchainGasLimit
+	}
+
+	// sign and send transaction with next nonce, it's tracked until mined
+	signedTx, err := tb.rootchainNonceManager.Send(context.Background(), *msg.To, msg.Value, auth.GasLimit, auth.GasPrice, msg.Data)
+	if err != nil {
+		tb.logger.Error("Error while broadcasting the transaction to rootchain", "error", err)
+		metrics.BroadcastFailure.WithLabelValues(metrics.RootChain).Inc()
+		return nil, err
+	}
+
+	tb.logger.Info("Sent transaction to rootchain", "txHash", signedTx.Hash())
+	metrics.BroadcastSuccess.WithLabelValues(metrics.RootChain).Inc()
+
+	return signedTx, nil
+}
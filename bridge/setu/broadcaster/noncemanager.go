@@ -0,0 +1,394 @@
+package broadcaster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	ethCommon "github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/common"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	// DefaultTxPollInterval is interval between receipt checks of tracked transactions
+	DefaultTxPollInterval = 15 * time.Second
+
+	// MinTxGasBumpPercent is min gas price increase accepted by nodes for replacement transactions
+	MinTxGasBumpPercent = uint64(10)
+)
+
+// txClient is part of eth client used to send and track transactions
+type txClient interface {
+	PendingNonceAt(ctx context.Context, account ethCommon.Address) (uint64, error)
+	NonceAt(ctx context.Context, account ethCommon.Address, blockNumber *big.Int) (uint64, error)
+	TransactionReceipt(ctx context.Context, txHash ethCommon.Hash) (*types.Receipt, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// TrackedTx is transaction sent by bridge which is not mined yet
+type TrackedTx struct {
+	Nonce    uint64            `json:"nonce"`
+	To       ethCommon.Address `json:"to"`
+	Value    *big.Int          `json:"value"`
+	GasLimit uint64            `json:"gasLimit"`
+	GasPrice *big.Int          `json:"gasPrice"`
+	Data     []byte            `json:"data"`
+	TxHashes []ethCommon.Hash  `json:"txHashes"` // hashes of all submissions, latest last
+	SentAt   time.Time         `json:"sentAt"`   // time of latest submission
+}
+
+// NonceManager assigns nonces to transactions sent from bridge account, tracks them in bridge db
+// until mined and replaces stuck transactions with bumped gas price
+type NonceManager struct {
+	common.BaseService
+
+	chain  string
+	client txClient
+	db     *leveldb.DB
+	auth   *bind.TransactOpts
+
+	resubmitTimeout time.Duration
+	gasBumpPercent  uint64
+	maxGasPrice     *big.Int // nil if gas price is not capped
+	pollInterval    time.Duration
+
+	mutex  sync.Mutex
+	cancel context.CancelFunc
+}
+
+// NewNonceManager creates nonce manager for given chain (eg. matic)
+func NewNonceManager(chain string, client txClient, db *leveldb.DB, auth *bind.TransactOpts) *NonceManager {
+	// config files generated by older versions have no resubmission settings
+	resubmitTimeout := helper.GetConfig().TxResubmitTimeout
+	if resubmitTimeout == 0 {
+		resubmitTimeout = helper.DefaultTxResubmitTimeout
+	}
+
+	gasBumpPercent := helper.GetConfig().TxGasBumpPercent
+	if gasBumpPercent == 0 {
+		gasBumpPercent = helper.DefaultTxGasBumpPercent
+	} else if gasBumpPercent < MinTxGasBumpPercent {
+		gasBumpPercent = MinTxGasBumpPercent
+	}
+
+	nm := &NonceManager{
+		chain:           chain,
+		client:          client,
+		db:              db,
+		auth:            auth,
+		resubmitTimeout: resubmitTimeout,
+		gasBumpPercent:  gasBumpPercent,
+		pollInterval:    DefaultTxPollInterval,
+	}
+	nm.BaseService = *common.NewBaseService(util.Logger().With("module", "nonceManager", "chain", chain), "NonceManager", nm)
+	return nm
+}
+
+// WithMaxGasPrice caps gas price of sent and resubmitted transactions, zero max gas price means no cap
+func (nm *NonceManager) WithMaxGasPrice(maxGasPrice *big.Int) *NonceManager {
+	if maxGasPrice == nil || maxGasPrice.Sign() <= 0 {
+		nm.maxGasPrice = nil
+		return nm
+	}
+
+	nm.maxGasPrice = maxGasPrice
+	return nm
+}
+
+// OnStart starts polling receipts of tracked transactions
+func (nm *NonceManager) OnStart() error {
+	if err := nm.BaseService.OnStart(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	nm.cancel = cancel
+
+	go func() {
+		ticker := time.NewTicker(nm.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := nm.CheckTracked(ctx); err != nil {
+					nm.Logger.Error("Error while checking tracked transactions", "error", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// OnStop stops polling
+func (nm *NonceManager) OnStop() {
+	nm.BaseService.OnStop()
+	if nm.cancel != nil {
+		nm.cancel()
+	}
+}
+
+// Send signs transaction with next nonce, sends it and tracks it until mined
+func (nm *NonceManager) Send(ctx context.Context, to ethCommon.Address, value *big.Int, gasLimit uint64, gasPrice *big.Int, data []byte) (*types.Transaction, error) {
+	nm.mutex.Lock()
+	defer nm.mutex.Unlock()
+
+	nonce, err := nm.nextNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if nm.maxGasPrice != nil && gasPrice.Cmp(nm.maxGasPrice) > 0 {
+		nm.Logger.Info("Suggested gas price is above max gas price, using max gas price", "gasPrice", gasPrice, "maxGasPrice", nm.maxGasPrice)
+		gasPrice = nm.maxGasPrice
+	}
+
+	tx := &TrackedTx{
+		Nonce:    nonce,
+		To:       to,
+		Value:    value,
+		GasLimit: gasLimit,
+		GasPrice: gasPrice,
+		Data:     data,
+	}
+
+	signedTx, err := nm.submit(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	nm.Logger.Info("Sent transaction", "txHash", signedTx.Hash(), "nonce", nonce, "gasPrice", gasPrice)
+	return signedTx, nil
+}
+
+// Tracked returns tracked transactions ordered by nonce
+func (nm *NonceManager) Tracked() ([]*TrackedTx, error) {
+	iter := nm.db.NewIterator(leveldbUtil.BytesPrefix([]byte(nm.keyPrefix())), nil)
+	defer iter.Release()
+
+	var result []*TrackedTx
+	for iter.Next() {
+		var tx TrackedTx
+		if err := json.Unmarshal(iter.Value(), &tx); err != nil {
+			return nil, err
+		}
+		result = append(result, &tx)
+	}
+
+	return result, iter.Error()
+}
+
+// WaitMined waits until transaction with nonce is mined and returns its receipt. Resubmissions
+// of the transaction are followed, error is returned if it is dropped without being mined.
+func (nm *NonceManager) WaitMined(ctx context.Context, nonce uint64, txHash ethCommon.Hash) (*types.Receipt, error) {
+	tx := &TrackedTx{Nonce: nonce, TxHashes: []ethCommon.Hash{txHash}}
+
+	ticker := time.NewTicker(nm.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// tracked tx has hashes of all submissions, it's removed once mined or dropped
+		tracked := true
+		value, err := nm.db.Get(nm.key(nonce), nil)
+		if err == leveldb.ErrNotFound {
+			tracked = false
+		} else if err != nil {
+			return nil, err
+		} else if err := json.Unmarshal(value, tx); err != nil {
+			return nil, err
+		}
+
+		receipt, err := nm.receipt(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+		if !tracked {
+			return nil, fmt.Errorf("Transaction with nonce %v dropped before it was mined", nonce)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// CheckTracked removes mined and dropped transactions and replaces stuck ones
+func (nm *NonceManager) CheckTracked(ctx context.Context) error {
+	nm.mutex.Lock()
+	defer nm.mutex.Unlock()
+
+	tracked, err := nm.Tracked()
+	if err != nil {
+		return err
+	}
+
+	if len(tracked) == 0 {
+		return nil
+	}
+
+	// fetched before receipts, so that tx mined in between is not reported as dropped
+	confirmedNonce, err := nm.client.NonceAt(ctx, nm.auth.From, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range tracked {
+		receipt, err := nm.receipt(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case receipt != nil:
+			if receipt.Status == types.ReceiptStatusFailed {
+				nm.Logger.Error("Transaction mined but failed", "txHash", receipt.TxHash, "nonce", tx.Nonce)
+			} else {
+				nm.Logger.Info("Transaction mined", "txHash", receipt.TxHash, "nonce", tx.Nonce, "block", receipt.BlockNumber)
+			}
+
+			if err := nm.db.Delete(nm.key(tx.Nonce), nil); err != nil {
+				return err
+			}
+
+		case tx.Nonce < confirmedNonce:
+			// nonce is used by transaction not sent by this bridge (or not tracked)
+			nm.Logger.Error("Transaction dropped, nonce used by another transaction", "txHash", tx.TxHashes[len(tx.TxHashes)-1], "nonce", tx.Nonce)
+			metrics.TxDropped.WithLabelValues(nm.chain).Inc()
+
+			if err := nm.db.Delete(nm.key(tx.Nonce), nil); err != nil {
+				return err
+			}
+
+		case time.Since(tx.SentAt) > nm.resubmitTimeout:
+			if nm.maxGasPrice != nil && tx.GasPrice.Cmp(nm.maxGasPrice) >= 0 {
+				nm.Logger.Error("Transaction is stuck at max gas price", "txHash", tx.TxHashes[len(tx.TxHashes)-1], "nonce", tx.Nonce, "gasPrice", tx.GasPrice)
+				continue
+			}
+
+			oldGasPrice := tx.GasPrice
+			tx.GasPrice = bumpGasPrice(tx.GasPrice, nm.gasBumpPercent)
+			if nm.maxGasPrice != nil && tx.GasPrice.Cmp(nm.maxGasPrice) > 0 {
+				tx.GasPrice = nm.maxGasPrice
+			}
+
+			signedTx, err := nm.submit(ctx, tx)
+			if err != nil {
+				// tx stays tracked, resubmission is attempted again on next check
+				nm.Logger.Error("Error while resubmitting stuck transaction", "nonce", tx.Nonce, "error", err)
+				continue
+			}
+
+			nm.Logger.Info("Resubmitted stuck transaction", "txHash", signedTx.Hash(), "nonce", tx.Nonce, "oldGasPrice", oldGasPrice, "gasPrice", tx.GasPrice)
+			metrics.TxResubmitted.WithLabelValues(nm.chain).Inc()
+		}
+	}
+
+	return nil
+}
+
+// nextNonce returns pending nonce from node, or next nonce after tracked transactions
+// if node doesn't know about them (eg. dropped from its tx pool)
+func (nm *NonceManager) nextNonce(ctx context.Context) (uint64, error) {
+	nonce, err := nm.client.PendingNonceAt(ctx, nm.auth.From)
+	if err != nil {
+		return 0, err
+	}
+
+	iter := nm.db.NewIterator(leveldbUtil.BytesPrefix([]byte(nm.keyPrefix())), nil)
+	defer iter.Release()
+
+	if iter.Last() {
+		var tx TrackedTx
+		if err := json.Unmarshal(iter.Value(), &tx); err != nil {
+			return 0, err
+		}
+
+		if tx.Nonce >= nonce {
+			nonce = tx.Nonce + 1
+		}
+	}
+
+	return nonce, iter.Error()
+}
+
+// submit signs and sends tx, and stores it with the new hash
+func (nm *NonceManager) submit(ctx context.Context, tx *TrackedTx) (*types.Transaction, error) {
+	rawTx := types.NewTransaction(tx.Nonce, tx.To, tx.Value, tx.GasLimit, tx.GasPrice, tx.Data)
+	signedTx, err := nm.auth.Signer(types.HomesteadSigner{}, nm.auth.From, rawTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := nm.client.SendTransaction(ctx, signedTx); err != nil {
+		return nil, err
+	}
+
+	tx.TxHashes = append(tx.TxHashes, signedTx.Hash())
+	tx.SentAt = time.Now()
+
+	value, err := json.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return signedTx, nm.db.Put(nm.key(tx.Nonce), value, nil)
+}
+
+// receipt returns receipt of any submission of the tx, nil if none is mined
+func (nm *NonceManager) receipt(ctx context.Context, tx *TrackedTx) (*types.Receipt, error) {
+	for _, txHash := range tx.TxHashes {
+		receipt, err := nm.client.TransactionReceipt(ctx, txHash)
+		if err == ethereum.NotFound {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if receipt != nil {
+			return receipt, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (nm *NonceManager) keyPrefix() string {
+	return nm.chain + "-tracked-tx-"
+}
+
+// key returns tracked tx key (zero padded nonce keeps keys sorted)
+func (nm *NonceManager) key(nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", nm.keyPrefix(), nonce))
+}
+
+// bumpGasPrice increases gas price by given percent, rounded up (at least by 1 wei)
+func bumpGasPrice(gasPrice *big.Int, percent uint64) *big.Int {
+	bump := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(percent))
+	bump.Add(bump, big.NewInt(99))
+	bump.Div(bump, big.NewInt(100))
+	if bump.Sign() == 0 {
+		bump.SetInt64(1)
+	}
+
+	return new(big.Int).Add(gasPrice, bump)
+}
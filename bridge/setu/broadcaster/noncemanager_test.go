@@ -0,0 +1,227 @@
+package broadcaster
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	ethCommon "github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// mockTxClient keeps sent txs and mines them on demand
+type mockTxClient struct {
+	pendingNonce   uint64
+	confirmedNonce uint64
+	sent           []*types.Transaction
+	receipts       map[ethCommon.Hash]*types.Receipt
+}
+
+func (c *mockTxClient) PendingNonceAt(ctx context.Context, account ethCommon.Address) (uint64, error) {
+	return c.pendingNonce, nil
+}
+
+func (c *mockTxClient) NonceAt(ctx context.Context, account ethCommon.Address, blockNumber *big.Int) (uint64, error) {
+	return c.confirmedNonce, nil
+}
+
+func (c *mockTxClient) TransactionReceipt(ctx context.Context, txHash ethCommon.Hash) (*types.Receipt, error) {
+	if receipt, ok := c.receipts[txHash]; ok {
+		return receipt, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func (c *mockTxClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	c.sent = append(c.sent, tx)
+	return nil
+}
+
+func (c *mockTxClient) mine(tx *types.Transaction) {
+	c.receipts[tx.Hash()] = &types.Receipt{TxHash: tx.Hash(), Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(1)}
+	c.confirmedNonce = tx.Nonce() + 1
+}
+
+func newTestNonceManager(t *testing.T) (*NonceManager, *mockTxClient) {
+	viper.Set("log_level", "info")
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	client := &mockTxClient{receipts: make(map[ethCommon.Hash]*types.Receipt)}
+	nm := NewNonceManager("matic", client, db, bind.NewKeyedTransactor(key))
+	nm.resubmitTimeout = time.Minute
+	nm.gasBumpPercent = 20
+	return nm, client
+}
+
+func TestNonceManagerSend(t *testing.T) {
+	nm, client := newTestNonceManager(t)
+	ctx := context.Background()
+	to := ethCommon.HexToAddress("0x01")
+
+	client.pendingNonce = 5
+	tx1, err := nm.Send(ctx, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), tx1.Nonce())
+
+	// node doesn't know about tracked tx, next nonce follows tracked txs
+	tx2, err := nm.Send(ctx, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), tx2.Nonce())
+
+	tracked, err := nm.Tracked()
+	require.NoError(t, err)
+	require.Len(t, tracked, 2)
+
+	// mined txs are removed
+	client.mine(tx1)
+	require.NoError(t, nm.CheckTracked(ctx))
+	tracked, err = nm.Tracked()
+	require.NoError(t, err)
+	require.Len(t, tracked, 1)
+	require.Equal(t, uint64(6), tracked[0].Nonce)
+}
+
+func TestNonceManagerResubmit(t *testing.T) {
+	nm, client := newTestNonceManager(t)
+	ctx := context.Background()
+	to := ethCommon.HexToAddress("0x01")
+
+	tx, err := nm.Send(ctx, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+	require.NoError(t, err)
+
+	// not timed out yet
+	require.NoError(t, nm.CheckTracked(ctx))
+	require.Len(t, client.sent, 1)
+
+	// stuck tx is replaced with same nonce and bumped gas price
+	nm.resubmitTimeout = 0
+	require.NoError(t, nm.CheckTracked(ctx))
+	require.Len(t, client.sent, 2)
+	require.Equal(t, tx.Nonce(), client.sent[1].Nonce())
+	require.Equal(t, big.NewInt(120), client.sent[1].GasPrice())
+
+	tracked, err := nm.Tracked()
+	require.NoError(t, err)
+	require.Len(t, tracked[0].TxHashes, 2)
+
+	// original tx mined after replacement
+	client.mine(tx)
+	require.NoError(t, nm.CheckTracked(ctx))
+	tracked, err = nm.Tracked()
+	require.NoError(t, err)
+	require.Empty(t, tracked)
+}
+
+func TestNonceManagerDropped(t *testing.T) {
+	nm, client := newTestNonceManager(t)
+	ctx := context.Background()
+
+	_, err := nm.Send(ctx, ethCommon.HexToAddress("0x01"), big.NewInt(0), 21000, big.NewInt(100), nil)
+	require.NoError(t, err)
+
+	// nonce used by another tx
+	client.confirmedNonce = 1
+	require.NoError(t, nm.CheckTracked(ctx))
+
+	tracked, err := nm.Tracked()
+	require.NoError(t, err)
+	require.Empty(t, tracked)
+	require.Len(t, client.sent, 1)
+}
+
+func TestNonceManagerWaitMined(t *testing.T) {
+	nm, client := newTestNonceManager(t)
+	nm.pollInterval = time.Millisecond
+	ctx := context.Background()
+	to := ethCommon.HexToAddress("0x01")
+
+	// resubmitted tx is mined with new hash
+	tx, err := nm.Send(ctx, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+	require.NoError(t, err)
+	nm.resubmitTimeout = 0
+	require.NoError(t, nm.CheckTracked(ctx))
+	nm.resubmitTimeout = time.Minute
+	client.mine(client.sent[1])
+
+	receipt, err := nm.WaitMined(ctx, tx.Nonce(), tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, client.sent[1].Hash(), receipt.TxHash)
+
+	// mined tx which is no longer tracked
+	require.NoError(t, nm.CheckTracked(ctx))
+	client.pendingNonce = client.confirmedNonce
+	tx, err = nm.Send(ctx, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+	require.NoError(t, err)
+	client.mine(tx)
+	require.NoError(t, nm.CheckTracked(ctx))
+
+	receipt, err = nm.WaitMined(ctx, tx.Nonce(), tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, tx.Hash(), receipt.TxHash)
+
+	// pending tx until context is done
+	client.pendingNonce = client.confirmedNonce
+	tx, err = nm.Send(ctx, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+	require.NoError(t, err)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = nm.WaitMined(timeoutCtx, tx.Nonce(), tx.Hash())
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	// dropped tx
+	client.confirmedNonce = tx.Nonce() + 1
+	require.NoError(t, nm.CheckTracked(ctx))
+	_, err = nm.WaitMined(ctx, tx.Nonce(), tx.Hash())
+	require.Error(t, err)
+}
+
+func TestNonceManagerMaxGasPrice(t *testing.T) {
+	nm, client := newTestNonceManager(t)
+	nm.WithMaxGasPrice(big.NewInt(150))
+	ctx := context.Background()
+
+	// suggested gas price is capped
+	tx, err := nm.Send(ctx, ethCommon.HexToAddress("0x01"), big.NewInt(0), 21000, big.NewInt(200), nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(150), tx.GasPrice())
+
+	// not resubmitted at max gas price
+	nm.resubmitTimeout = 0
+	require.NoError(t, nm.CheckTracked(ctx))
+	require.Len(t, client.sent, 1)
+
+	// zero max gas price doesn't cap gas price
+	nm.WithMaxGasPrice(big.NewInt(0))
+	tx, err = nm.Send(ctx, ethCommon.HexToAddress("0x01"), big.NewInt(0), 21000, big.NewInt(200), nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(200), tx.GasPrice())
+}
+
+func TestBumpGasPrice(t *testing.T) {
+	require.Equal(t, big.NewInt(110), bumpGasPrice(big.NewInt(100), 10))
+	require.Equal(t, big.NewInt(2), bumpGasPrice(big.NewInt(1), 10))
+	require.Equal(t, big.NewInt(116), bumpGasPrice(big.NewInt(105), 10))
+}
+
+func TestNonceManagerDefaults(t *testing.T) {
+	// config without resubmission settings
+	nm, _ := newTestNonceManager(t)
+	nm = NewNonceManager("matic", nm.client, nm.db, nm.auth)
+	require.Equal(t, helper.DefaultTxResubmitTimeout, nm.resubmitTimeout)
+	require.Equal(t, helper.DefaultTxGasBumpPercent, nm.gasBumpPercent)
+}
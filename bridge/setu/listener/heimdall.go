@@ -9,6 +9,8 @@ import (
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +23,12 @@ const (
 	heimdallLastBlockKey = "heimdall-last-block" // storage key
 )
 
+// heimdallEventActions are msg actions whose events are processed by bridge
+var heimdallEventActions = []string{
+	checkpointTypes.MsgCheckpoint{}.Type(),
+	clerkTypes.MsgEventRecord{}.Type(),
+}
+
 // HeimdallListener - Listens to and process events from heimdall
 type HeimdallListener struct {
 	BaseListener
@@ -45,6 +53,17 @@ func (hl *HeimdallListener) Start() error {
 		pollInterval = helper.GetConfig().CheckpointerPollInterval
 	}
 
+	if helper.GetConfig().HeimdallListenerMode == helper.SubscriptionListenerMode {
+		// subscribe to heimdall events
+		err := hl.startEventSubscription(headerCtx, pollInterval)
+		if err == nil {
+			hl.Logger.Info("Subscribed to heimdall events")
+			return nil
+		}
+
+		hl.Logger.Error("Error while subscribing to heimdall events, falling back to polling", "error", err)
+	}
+
 	hl.Logger.Info("Start polling for events", "pollInterval", pollInterval)
 	hl.StartPolling(headerCtx, pollInterval)
 	return nil
@@ -64,52 +83,16 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 	// the ending of the interval
 	ticker := time.NewTicker(interval)
 
-	var eventTypes []string
-	eventTypes = append(eventTypes, "message.action='checkpoint'")
-	eventTypes = append(eventTypes, "message.action='event-record'")
-
 	// start listening
 	for {
 		select {
 		case <-ticker.C:
 			fromBlock, toBlock := hl.fetchFromAndToBlock()
 			if fromBlock < toBlock {
-				for _, eventType := range eventTypes {
-					var query []string
-					query = append(query, eventType)
-					query = append(query, fmt.Sprintf("tx.height>=%v", fromBlock))
-					query = append(query, fmt.Sprintf("tx.height<=%v", toBlock))
-
-					limit := 50
-					for page := 1; page > 0; {
-						searchResult, err := helper.QueryTxsByEvents(hl.cliCtx, query, page, limit)
-						hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", limit)
-
-						if err != nil {
-							hl.Logger.Error("Error while searching events", "eventType", eventType, "error", err)
-							break
-						}
-
-						for _, tx := range searchResult.Txs {
-							for _, log := range tx.Logs {
-								event := helper.FilterEvents(log.Events, func(et sdk.StringEvent) bool {
-									return et.Type == checkpointTypes.EventTypeCheckpoint || et.Type == clerkTypes.EventTypeRecord
-								})
-								if event != nil {
-									hl.ProcessEvent(*event, tx)
-								}
-							}
-						}
-
-						if len(searchResult.Txs) == limit {
-							page = page + 1
-						} else {
-							page = 0
-						}
-					}
-				}
+				hl.processBlockRange(fromBlock, toBlock)
+
 				// set last block to storage
-				hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(toBlock, 10)), nil)
+				hl.setLastBlock(toBlock)
 			}
 
 		case <-ctx.Done():
@@ -120,32 +103,98 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 	}
 }
 
+// processBlockRange searches heimdall txs with bridge events in given block range and processes them
+func (hl *HeimdallListener) processBlockRange(fromBlock uint64, toBlock uint64) {
+	for _, action := range heimdallEventActions {
+		eventType := fmt.Sprintf("message.action='%v'", action)
+
+		var query []string
+		query = append(query, eventType)
+		query = append(query, fmt.Sprintf("tx.height>=%v", fromBlock))
+		query = append(query, fmt.Sprintf("tx.height<=%v", toBlock))
+
+		limit := 50
+		for page := 1; page > 0; {
+			searchResult, err := helper.QueryTxsByEvents(hl.cliCtx, query, page, limit)
+			hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", limit)
+
+			if err != nil {
+				hl.Logger.Error("Error while searching events", "eventType", eventType, "error", err)
+				break
+			}
+
+			for _, tx := range searchResult.Txs {
+				hl.processTx(tx)
+			}
+
+			if len(searchResult.Txs) == limit {
+				page = page + 1
+			} else {
+				page = 0
+			}
+		}
+	}
+}
+
+// processTx processes bridge events from heimdall tx
+func (hl *HeimdallListener) processTx(tx sdk.TxResponse) {
+	for _, log := range tx.Logs {
+		event := helper.FilterEvents(log.Events, func(et sdk.StringEvent) bool {
+			return et.Type == checkpointTypes.EventTypeCheckpoint || et.Type == clerkTypes.EventTypeRecord
+		})
+		if event != nil {
+			hl.ProcessEvent(*event, tx)
+		}
+	}
+}
+
+// setLastBlock stores last processed heimdall block
+func (hl *HeimdallListener) setLastBlock(lastBlock uint64) {
+	hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(lastBlock, 10)), nil)
+	metrics.LastProcessedBlock.WithLabelValues(hl.String()).Set(float64(lastBlock))
+}
+
 func (hl *HeimdallListener) fetchFromAndToBlock() (fromBlock uint64, toBlock uint64) {
 	// toBlock - get latest blockheight from heimdall node
 	nodeStatus, _ := helper.GetNodeStatus(hl.cliCtx)
 	toBlock = uint64(nodeStatus.SyncInfo.LatestBlockHeight)
 
 	// fromBlock - get last block from storage
-	hasLastBlock, _ := hl.storageClient.Has([]byte(heimdallLastBlockKey), nil)
-	if hasLastBlock {
-		lastBlockBytes, err := hl.storageClient.Get([]byte(heimdallLastBlockKey), nil)
-		if err != nil {
-			hl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
-			return
-		}
+	lastBlock, found, err := hl.getLastBlock()
+	if err != nil {
+		toBlock = 0
+		return
+	}
 
-		if result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil {
-			hl.Logger.Debug("Got last block from bridge storage", "lastBlock", result)
-			fromBlock = uint64(result) + 1
-		} else {
-			hl.Logger.Info("Error parsing last block bytes from storage", "error", err)
-			toBlock = 0
-			return
-		}
+	if found {
+		fromBlock = lastBlock + 1
 	}
 	return
 }
 
+// getLastBlock returns last processed heimdall block from storage
+func (hl *HeimdallListener) getLastBlock() (lastBlock uint64, found bool, err error) {
+	hasLastBlock, _ := hl.storageClient.Has([]byte(heimdallLastBlockKey), nil)
+	if !hasLastBlock {
+		return
+	}
+
+	lastBlockBytes, err := hl.storageClient.Get([]byte(heimdallLastBlockKey), nil)
+	if err != nil {
+		hl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
+		return
+	}
+
+	lastBlock, err = strconv.ParseUint(string(lastBlockBytes), 10, 64)
+	if err != nil {
+		hl.Logger.Info("Error parsing last block bytes from storage", "error", err)
+		return
+	}
+
+	hl.Logger.Debug("Got last block from bridge storage", "lastBlock", lastBlock)
+	return lastBlock, true, nil
+}
+
 // ProcessEvent - process event from heimdall.
 func (hl *HeimdallListener) ProcessEvent(event sdk.StringEvent, tx sdk.TxResponse) {
 	hl.Logger.Info("Process received event from Heimdall", "eventType", event.Type)
@@ -190,7 +239,7 @@ func (hl *HeimdallListener) sendTask(taskName string, eventBytes []byte, txHeigh
 			},
 		},
 	}
-	signature.RetryCount = 3
+	signature.RetryCount = util.TaskRetryCount
 	hl.Logger.Info("Sending task", "taskName", taskName, "currentTime", time.Now())
 	// send task
 	_, err := hl.queueConnector.Server.SendTask(signature)
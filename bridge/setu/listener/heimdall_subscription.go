@@ -0,0 +1,99 @@
+package listener
+
+import (
+	"context"
+	"time"
+
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	heimdallSubscriber   = "heimdall-listener"
+	subscriptionCapacity = 1
+
+	// subscriptionTimeout is max time without new block header, after which
+	// subscription is considered cancelled and listener falls back to polling
+	subscriptionTimeout = 2 * time.Minute
+)
+
+// startEventSubscription subscribes to heimdall block headers over websocket.
+//
+// Headers only trigger processing: once header H is received, every block up to H-1 is
+// completed and all blocks since last processed block are processed using tx search,
+// same as polling. So dropped or missed headers (eg. after websocket reconnect) never
+// lose events, next header processes the whole range.
+func (hl *HeimdallListener) startEventSubscription(ctx context.Context, pollInterval time.Duration) error {
+	// dedicated websocket client, so that subscriptions are not affected by other users
+	client := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
+	if err := client.Start(); err != nil {
+		return err
+	}
+
+	headerCh, err := client.Subscribe(ctx, heimdallSubscriber, tmTypes.EventQueryNewBlockHeader.String(), subscriptionCapacity)
+	if err != nil {
+		client.Stop()
+		return err
+	}
+
+	go hl.processSubscription(ctx, client, headerCh, pollInterval)
+	return nil
+}
+
+// processSubscription processes completed blocks on each new header until context is cancelled.
+// If no header is received for subscription timeout, subscription is stopped and polling is started.
+func (hl *HeimdallListener) processSubscription(ctx context.Context, client *httpClient.HTTP, headerCh <-chan ctypes.ResultEvent, pollInterval time.Duration) {
+	timer := time.NewTimer(subscriptionTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case event := <-headerCh:
+			data, ok := event.Data.(tmTypes.EventDataNewBlockHeader)
+			if !ok {
+				continue
+			}
+			hl.processCompletedBlocks(uint64(data.Header.Height - 1))
+
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(subscriptionTimeout)
+
+		case <-timer.C:
+			hl.Logger.Error("No heimdall block header received, falling back to polling", "timeout", subscriptionTimeout)
+			client.Stop()
+			hl.StartPolling(ctx, pollInterval)
+			return
+
+		case <-ctx.Done():
+			hl.Logger.Info("Subscription stopped")
+			client.Stop()
+			return
+		}
+	}
+}
+
+// processCompletedBlocks processes blocks after last processed block up to completed block
+func (hl *HeimdallListener) processCompletedBlocks(completed uint64) {
+	lastBlock, found, err := hl.getLastBlock()
+	if err != nil {
+		hl.Logger.Error("Error while fetching last block", "error", err)
+		return
+	}
+
+	fromBlock := uint64(0)
+	if found {
+		fromBlock = lastBlock + 1
+	}
+
+	if fromBlock > completed {
+		return
+	}
+
+	hl.processBlockRange(fromBlock, completed)
+	hl.setLastBlock(completed)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 )
@@ -64,6 +65,7 @@ func (ml *MaticChainListener) ProcessHeader(newHeader *types.Header) {
 
 	confirmationTime := configParams.TxConfirmationTime
 	ml.sendTaskWithDelay("sendCheckpointToHeimdall", headerBytes, confirmationTime)
+	metrics.LastProcessedBlock.WithLabelValues(ml.String()).Set(float64(newHeader.Number.Uint64()))
 }
 
 func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []byte, delay time.Duration) {
@@ -77,7 +79,7 @@ func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []b
 			},
 		},
 	}
-	signature.RetryCount = 3
+	signature.RetryCount = util.TaskRetryCount
 
 	// add delay for task so that multiple validators won't send same transaction at same time
 	eta := time.Now().Add(delay)
@@ -14,6 +14,7 @@ import (
 	"github.com/maticnetwork/bor/accounts/abi"
 	ethCommon "github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
@@ -28,6 +29,9 @@ type RootChainListener struct {
 	// queue
 	headerQueue    *list.List
 	stakingInfoAbi *abi.ABI
+
+	// processed block hashes for reorg detection
+	blockTracker *util.BlockTracker
 }
 
 const (
@@ -98,6 +102,9 @@ func (rl *RootChainListener) ProcessHeader(newHeader *types.Header) {
 	configParams, _ := util.GetConfigManagerParams(rl.cliCtx)
 	confirmationTime := uint64(configParams.TxConfirmationTime.Seconds())
 
+	// only process blocks with enough confirmations, others stay in queue
+	confirmations := helper.GetConfig().MainchainConfirmations
+
 	var start *big.Int
 	var end *big.Int
 
@@ -108,6 +115,9 @@ func (rl *RootChainListener) ProcessHeader(newHeader *types.Header) {
 		if h.Time+confirmationTime > currentTime {
 			break
 		}
+		if h.Number.Uint64()+confirmations > newHeader.Number.Uint64() {
+			break
+		}
 		if start == nil {
 			start = h.Number
 		}
@@ -138,21 +148,97 @@ func (rl *RootChainListener) ProcessHeader(newHeader *types.Header) {
 		}
 	}
 
+	// rewind to common ancestor if processed blocks were reorged out
+	if ancestor, reorged := rl.checkReorg(); reorged && ancestor+1 < fromBlock.Uint64() {
+		fromBlock = big.NewInt(0).SetUint64(ancestor + 1)
+	}
+
 	// to block
 	toBlock := end
 
-	// debug log
-	rl.Logger.Info("Processing header", "fromBlock", fromBlock, "toBlock", toBlock)
-
 	if toBlock.Cmp(fromBlock) == -1 {
-		fromBlock = toBlock
+		rl.Logger.Debug("No new confirmed blocks to process", "fromBlock", fromBlock, "toBlock", toBlock)
+		return
 	}
 
+	// debug log
+	rl.Logger.Info("Processing header", "fromBlock", fromBlock, "toBlock", toBlock)
+
 	// set last block to storage
 	rl.storageClient.Put([]byte(lastRootBlockKey), []byte(toBlock.String()), nil)
+	metrics.LastProcessedBlock.WithLabelValues(rl.String()).Set(float64(toBlock.Uint64()))
 
 	// query log
 	rl.queryAndBroadcastEvents(fromBlock, toBlock)
+
+	// track processed block for reorg detection
+	if header, err := rl.contractConnector.MainChainClient.HeaderByNumber(context.Background(), toBlock); err != nil {
+		rl.Logger.Error("Error while fetching processed header", "block", toBlock, "error", err)
+	} else if err := rl.blockTracker.Track(toBlock.Uint64(), header.Hash()); err != nil {
+		rl.Logger.Error("Error while tracking processed block", "block", toBlock, "error", err)
+	}
+
+	if err := rl.blockTracker.Prune(toBlock.Uint64()); err != nil {
+		rl.Logger.Error("Error while pruning tracked blocks", "error", err)
+	}
+}
+
+// checkReorg compares tracked block hashes with canonical chain.
+// On reorg it returns common ancestor and marks orphaned blocks stale,
+// so tasks already queued from their logs are skipped by processors.
+func (rl *RootChainListener) checkReorg() (uint64, bool) {
+	latest, err := rl.blockTracker.Latest()
+	if err != nil || latest == nil {
+		return 0, false
+	}
+
+	// ancestors of a canonical block are canonical, so checking latest is enough
+	if rl.isCanonical(latest) {
+		return 0, false
+	}
+
+	tracked, err := rl.blockTracker.Tracked()
+	if err != nil {
+		rl.Logger.Error("Error while fetching tracked blocks", "error", err)
+		return 0, false
+	}
+
+	// find common ancestor - newest tracked block still on canonical chain.
+	// If reorg is deeper than tracked window, rewind before oldest tracked block.
+	ancestor := tracked[len(tracked)-1].Number
+	if ancestor > 0 {
+		ancestor = ancestor - 1
+	}
+	for _, block := range tracked {
+		if rl.isCanonical(block) {
+			ancestor = block.Number
+			break
+		}
+	}
+
+	orphaned, err := rl.blockTracker.Rewind(ancestor)
+	if err != nil {
+		rl.Logger.Error("Error while rewinding tracked blocks", "ancestor", ancestor, "error", err)
+		return 0, false
+	}
+
+	rl.Logger.Info("Rootchain reorg detected, rewinding to common ancestor", "ancestor", ancestor, "orphanedBlocks", len(orphaned))
+
+	// set last block to ancestor so that range is queried again
+	rl.storageClient.Put([]byte(lastRootBlockKey), []byte(strconv.FormatUint(ancestor, 10)), nil)
+	return ancestor, true
+}
+
+// isCanonical checks if tracked block is still part of canonical chain
+func (rl *RootChainListener) isCanonical(block *util.TrackedBlock) bool {
+	header, err := rl.contractConnector.MainChainClient.HeaderByNumber(context.Background(), big.NewInt(0).SetUint64(block.Number))
+	if err != nil {
+		// treat as canonical to avoid rewinding on rpc errors
+		rl.Logger.Error("Error while fetching header for reorg check", "block", block.Number, "error", err)
+		return true
+	}
+
+	return header.Hash() == block.Hash
 }
 
 func (rl *RootChainListener) queryAndBroadcastEvents(fromBlock *big.Int, toBlock *big.Int) {
@@ -170,6 +256,10 @@ func (rl *RootChainListener) queryAndBroadcastEvents(fromBlock *big.Int, toBlock
 		configParams.ChainParams.StakingInfoAddress.EthAddress(),
 		configParams.ChainParams.StateSenderAddress.EthAddress(),
 	}}
+	// rootchain contracts of additional checkpoint chains
+	for _, chain := range configParams.ChainParams.CheckpointChains {
+		query.Addresses = append(query.Addresses, chain.RootChainAddress.EthAddress())
+	}
 	// get logs from rootchain by filter
 	logs, err := rl.contractConnector.MainChainClient.FilterLogs(context.Background(), query)
 	if err != nil {
@@ -181,6 +271,11 @@ func (rl *RootChainListener) queryAndBroadcastEvents(fromBlock *big.Int, toBlock
 
 	// process filtered log
 	for _, vLog := range logs {
+		// track log block, so that tasks from it can be marked stale on reorg
+		if err := rl.blockTracker.Track(vLog.BlockNumber, vLog.BlockHash); err != nil {
+			rl.Logger.Error("Error while tracking log block", "block", vLog.BlockNumber, "error", err)
+		}
+
 		topic := vLog.Topics[0].Bytes()
 		for _, abiObject := range rl.abis {
 			selectedEvent := helper.EventByID(abiObject, topic)
@@ -273,7 +368,7 @@ func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string
 			},
 		},
 	}
-	signature.RetryCount = 3
+	signature.RetryCount = util.TaskRetryCount
 
 	// add delay for task so that multiple validators won't send same transaction at same time
 	eta := time.Now().Add(delay)
@@ -2,6 +2,7 @@ package listener
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/maticnetwork/heimdall/bridge/setu/processor"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
@@ -16,6 +17,44 @@ const (
 	MaticChainListenerStr = "maticchain"
 )
 
+// ListenerNames are names of all listeners, accepted by --only flag
+var ListenerNames = []string{RootChainListenerStr, MaticChainListenerStr, HeimdallListenerStr}
+
+// listenerProcessors are processors consuming tasks sent by each listener
+var listenerProcessors = map[string][]string{
+	RootChainListenerStr: {
+		processor.CheckpointProcessorStr,
+		processor.StakingProcessorStr,
+		processor.ClerkProcessorStr,
+		processor.FeeProcessorStr,
+	},
+	MaticChainListenerStr: {processor.CheckpointProcessorStr},
+	HeimdallListenerStr:   {processor.CheckpointProcessorStr, processor.ClerkProcessorStr},
+}
+
+// IsListenerSelected checks if listener is selected with --all or --only flags,
+// either by listener name or by name of processor it feeds
+func IsListenerSelected(name string) bool {
+	return util.IsListenerSelected(name, listenerProcessors[name])
+}
+
+// IsAnyListenerSelected checks if any listener is selected
+func IsAnyListenerSelected() bool {
+	for _, name := range ListenerNames {
+		if IsListenerSelected(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LastBlockKeys are storage keys of last processed block, by listener name
+var LastBlockKeys = map[string]string{
+	RootChainListenerStr: lastRootBlockKey,
+	HeimdallListenerStr:  heimdallLastBlockKey,
+}
+
 // ListenerService starts and stops all chain event listeners
 type ListenerService struct {
 	// Base service
@@ -33,17 +72,25 @@ func NewListenerService(cdc *codec.Codec, queueConnector *queue.QueueConnector)
 
 	listenerService.BaseService = *common.NewBaseService(logger, ListenerServiceStr, listenerService)
 
-	rootchainListener := NewRootChainListener()
-	rootchainListener.BaseListener = *NewBaseListener(cdc, queueConnector, helper.GetMainClient(), RootChainListenerStr, rootchainListener)
-	listenerService.listeners = append(listenerService.listeners, rootchainListener)
+	// add selected listeners
+	if IsListenerSelected(RootChainListenerStr) {
+		rootchainListener := NewRootChainListener()
+		rootchainListener.BaseListener = *NewBaseListener(cdc, queueConnector, helper.GetMainClient(), RootChainListenerStr, rootchainListener)
+		rootchainListener.blockTracker = util.NewRootChainBlockTracker(rootchainListener.storageClient)
+		listenerService.listeners = append(listenerService.listeners, rootchainListener)
+	}
 
-	maticchainListener := &MaticChainListener{}
-	maticchainListener.BaseListener = *NewBaseListener(cdc, queueConnector, helper.GetMaticClient(), MaticChainListenerStr, maticchainListener)
-	listenerService.listeners = append(listenerService.listeners, maticchainListener)
+	if IsListenerSelected(MaticChainListenerStr) {
+		maticchainListener := &MaticChainListener{}
+		maticchainListener.BaseListener = *NewBaseListener(cdc, queueConnector, helper.GetMaticClient(), MaticChainListenerStr, maticchainListener)
+		listenerService.listeners = append(listenerService.listeners, maticchainListener)
+	}
 
-	heimdallListener := &HeimdallListener{}
-	heimdallListener.BaseListener = *NewBaseListener(cdc, queueConnector, nil, HeimdallListenerStr, heimdallListener)
-	listenerService.listeners = append(listenerService.listeners, heimdallListener)
+	if IsListenerSelected(HeimdallListenerStr) {
+		heimdallListener := &HeimdallListener{}
+		heimdallListener.BaseListener = *NewBaseListener(cdc, queueConnector, nil, HeimdallListenerStr, heimdallListener)
+		listenerService.listeners = append(listenerService.listeners, heimdallListener)
+	}
 
 	return listenerService
 }
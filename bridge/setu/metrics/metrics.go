@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "heimdall_bridge"
+
+	// Chain labels
+	HeimdallChain = "heimdall"
+	MaticChain    = "matic"
+	RootChain     = "rootchain"
+	listenerLabel = "listener"
+	taskLabel     = "task"
+	chainLabel    = "chain"
+)
+
+var (
+	// Registry is bridge prometheus registry
+	Registry = prometheus.NewRegistry()
+
+	// LastProcessedBlock is last block processed by each listener
+	LastProcessedBlock = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "listener_last_block",
+		Help:      "Last block processed by listener",
+	}, []string{listenerLabel})
+
+	// TasksSent counts tasks published to the queue (including retries)
+	TasksSent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tasks_sent_total",
+		Help:      "Number of tasks published to the queue",
+	}, []string{taskLabel})
+
+	// TasksRetried counts task retries
+	TasksRetried = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tasks_retried_total",
+		Help:      "Number of task retries",
+	}, []string{taskLabel})
+
+	// TasksProcessed counts successfully processed tasks
+	TasksProcessed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tasks_processed_total",
+		Help:      "Number of successfully processed tasks",
+	}, []string{taskLabel})
+
+	// TasksFailed counts tasks failed after all retries
+	TasksFailed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tasks_failed_total",
+		Help:      "Number of tasks failed after all retries",
+	}, []string{taskLabel})
+
+	// BroadcastSuccess counts successful tx broadcasts per chain
+	BroadcastSuccess = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "broadcast_success_total",
+		Help:      "Number of successful transaction broadcasts",
+	}, []string{chainLabel})
+
+	// BroadcastFailure counts failed tx broadcasts per chain
+	BroadcastFailure = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "broadcast_failure_total",
+		Help:      "Number of failed transaction broadcasts",
+	}, []string{chainLabel})
+
+	// TxResubmitted counts stuck transactions replaced with bumped gas price per chain
+	TxResubmitted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tx_resubmitted_total",
+		Help:      "Number of stuck transactions resubmitted with bumped gas price",
+	}, []string{chainLabel})
+
+	// TxDropped counts tracked transactions whose nonce was used by another transaction
+	TxDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tx_dropped_total",
+		Help:      "Number of tracked transactions dropped",
+	}, []string{chainLabel})
+
+	// AccountSequence is current heimdall account sequence used by broadcaster
+	AccountSequence = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "account_sequence",
+		Help:      "Current heimdall account sequence",
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		LastProcessedBlock,
+		TasksSent,
+		TasksRetried,
+		TasksProcessed,
+		TasksFailed,
+		BroadcastSuccess,
+		BroadcastFailure,
+		TxResubmitted,
+		TxDropped,
+		AccountSequence,
+	)
+}
+
+// RegisterQueueSize registers gauge for number of queued tasks
+func RegisterQueueSize(queueSize func() float64) {
+	Registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "queue_size",
+		Help:      "Number of pending and delayed tasks in the queue",
+	}, queueSize))
+}
@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// DefaultServerAddr is default listen address for bridge health and metrics server
+	DefaultServerAddr = "0.0.0.0:26670"
+
+	shutdownTimeout = 5 * time.Second
+)
+
+// Server serves bridge health, readiness and prometheus metrics
+type Server struct {
+	logger     log.Logger
+	httpServer *http.Server
+
+	// ready is set once bridge services are started
+	ready uint32 // atomic
+
+	// readyCheck runs additional readiness checks (eg. heimdall node synced)
+	readyCheck func() error
+}
+
+// NewServer creates health and metrics server
+func NewServer(logger log.Logger, addr string, readyCheck func() error) *Server {
+	s := &Server{
+		logger:     logger,
+		readyCheck: readyCheck,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", s.handleHealth)
+	mux.HandleFunc("/ready", s.handleReady)
+	mux.Handle("/metrics", promhttp.HandlerFor(Registry, promhttp.HandlerOpts{}))
+
+	s.httpServer = &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+
+	return s
+}
+
+// Start starts serving in background
+func (s *Server) Start() {
+	go func() {
+		s.logger.Info("Starting health and metrics server", "addr", s.httpServer.Addr)
+		if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			s.logger.Error("Error while serving health and metrics", "error", err)
+		}
+	}()
+}
+
+// Stop shuts down server
+func (s *Server) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	s.httpServer.Shutdown(ctx)
+}
+
+// SetReady marks bridge services as started or stopped
+func (s *Server) SetReady(ready bool) {
+	if ready {
+		atomic.StoreUint32(&s.ready, 1)
+	} else {
+		atomic.StoreUint32(&s.ready, 0)
+	}
+}
+
+// handleHealth reports that bridge process is alive
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeStatus(w, http.StatusOK, "ok")
+}
+
+// handleReady reports whether bridge services are started and dependencies are reachable
+func (s *Server) handleReady(w http.ResponseWriter, r *http.Request) {
+	if atomic.LoadUint32(&s.ready) == 0 {
+		writeStatus(w, http.StatusServiceUnavailable, "bridge services not started")
+		return
+	}
+
+	if s.readyCheck != nil {
+		if err := s.readyCheck(); err != nil {
+			writeStatus(w, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+	}
+
+	writeStatus(w, http.StatusOK, "ok")
+}
+
+func writeStatus(w http.ResponseWriter, code int, status string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]string{"status": status})
+}
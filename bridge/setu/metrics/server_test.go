@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestServerEndpoints(t *testing.T) {
+	var readyErr error
+	server := NewServer(log.NewNopLogger(), DefaultServerAddr, func() error {
+		return readyErr
+	})
+
+	get := func(path string) int {
+		recorder := httptest.NewRecorder()
+		server.httpServer.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder.Code
+	}
+
+	require.Equal(t, http.StatusOK, get("/health"))
+	require.Equal(t, http.StatusOK, get("/metrics"))
+
+	// not ready until services are started
+	require.Equal(t, http.StatusServiceUnavailable, get("/ready"))
+
+	server.SetReady(true)
+	require.Equal(t, http.StatusOK, get("/ready"))
+
+	// ready check failure
+	readyErr = errors.New("catching up")
+	require.Equal(t, http.StatusServiceUnavailable, get("/ready"))
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/maticnetwork/bor/core/types"
 	"github.com/spf13/viper"
 	"github.com/syndtr/goleveldb/leveldb"
 
@@ -53,6 +54,9 @@ type BaseProcessor struct {
 
 	// storage client
 	storageClient *leveldb.DB
+
+	// rootchain blocks orphaned by reorg
+	rootchainBlockTracker *util.BlockTracker
 }
 
 // NewBaseProcessor creates a new BaseProcessor.
@@ -73,6 +77,8 @@ func NewBaseProcessor(cdc *codec.Codec, queueConnector *queue.QueueConnector, ht
 		logger = log.NewNopLogger()
 	}
 
+	storageClient := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+
 	// creating syncer object
 	return &BaseProcessor{
 		Logger: logger,
@@ -85,7 +91,9 @@ func NewBaseProcessor(cdc *codec.Codec, queueConnector *queue.QueueConnector, ht
 		contractConnector: contractCaller,
 		txBroadcaster:     txBroadcaster,
 		httpClient:        httpClient,
-		storageClient:     util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+		storageClient:     storageClient,
+
+		rootchainBlockTracker: util.NewRootChainBlockTracker(storageClient),
 	}
 }
 
@@ -94,6 +102,21 @@ func (bp *BaseProcessor) String() string {
 	return bp.name
 }
 
+// isStaleLog checks if rootchain log belongs to a block orphaned by reorg
+func (bp *BaseProcessor) isStaleLog(vLog *types.Log) bool {
+	if bp.rootchainBlockTracker.IsStale(vLog.BlockHash) {
+		bp.Logger.Info("Ignoring task for log from orphaned rootchain block",
+			"blockNumber", vLog.BlockNumber,
+			"blockHash", vLog.BlockHash.Hex(),
+			"txHash", vLog.TxHash.Hex(),
+			"logIndex", vLog.Index,
+		)
+		return true
+	}
+
+	return false
+}
+
 // OnStop stops all necessary go routines
 func (bp *BaseProcessor) Stop() {
 	// override to stop any go-routines in individual processors
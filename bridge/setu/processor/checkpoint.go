@@ -4,26 +4,36 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"math"
 	"math/big"
 	"strconv"
 	"time"
 
+	"github.com/RichardKnop/machinery/v1/tasks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	// "github.com/streadway/amqp"
 
+	ethereum "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/ethclient"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 
 	// "github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/contracts/rootchain"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+// checkpointTxTimeout is max time to wait until checkpoint tx is mined on rootchain,
+// it covers few resubmissions of stuck tx with bumped gas price
+const checkpointTxTimeout = 20 * time.Minute
+
 // CheckpointProcessor - processor for checkpoint queue.
 type CheckpointProcessor struct {
 	BaseProcessor
@@ -31,6 +41,9 @@ type CheckpointProcessor struct {
 	// header listener subscription
 	cancelNoACKPolling context.CancelFunc
 
+	// header polling of additional checkpoint chains
+	cancelChainPolling context.CancelFunc
+
 	// Rootchain instance
 
 	// Rootchain abi
@@ -58,6 +71,27 @@ func (cp *CheckpointProcessor) Start() error {
 	cp.cancelNoACKPolling = cancelNoACKPolling
 	cp.Logger.Info("Start polling for no-ack", "pollInterval", helper.GetConfig().NoACKPollInterval)
 	go cp.startPollingForNoAck(ackCtx, helper.GetConfig().NoACKPollInterval)
+
+	// one pipeline for each additional checkpoint chain, default chain headers come from maticchain listener
+	chainCtx, cancelChainPolling := context.WithCancel(context.Background())
+	cp.cancelChainPolling = cancelChainPolling
+	if configParams, err := util.GetConfigManagerParams(cp.cliCtx); err != nil {
+		cp.Logger.Error("Error while fetching chain params, additional checkpoint chains are not started", "error", err)
+	} else {
+		for _, chain := range configParams.ChainParams.CheckpointChains {
+			// headers of another chain must never be checkpointed, refuse to start without endpoint
+			borClient, err := helper.GetBorClient(chain.BorChainID)
+			if err != nil {
+				cp.Logger.Error("Unable to start polling for checkpoint chain headers", "chainID", chain.ChainID, "error", err)
+				cancelChainPolling()
+				return err
+			}
+
+			cp.Logger.Info("Start polling for checkpoint chain headers", "chainID", chain.ChainID, "borChainID", chain.BorChainID, "pollInterval", helper.GetConfig().CheckpointerPollInterval)
+			go cp.startPollingForChainHeaders(chainCtx, chain, borClient, helper.GetConfig().CheckpointerPollInterval)
+		}
+	}
+
 	return nil
 }
 
@@ -65,6 +99,7 @@ func (cp *CheckpointProcessor) Start() error {
 func (cp *CheckpointProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering checkpoint tasks")
 	cp.queueConnector.Server.RegisterTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall)
+	cp.queueConnector.Server.RegisterTask("sendChainCheckpointToHeimdall", cp.sendChainCheckpointToHeimdall)
 	cp.queueConnector.Server.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain)
 	cp.queueConnector.Server.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall)
 }
@@ -85,18 +120,95 @@ func (cp *CheckpointProcessor) startPollingForNoAck(ctx context.Context, interva
 	}
 }
 
+// startPollingForChainHeaders polls latest header of additional checkpoint chain and queues
+// it for checkpoint proposal once tx confirmation time has passed, like maticchain listener does
+func (cp *CheckpointProcessor) startPollingForChainHeaders(ctx context.Context, chain chainmanagerTypes.CheckpointChain, borClient *ethclient.Client, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	// stop ticker when everything done
+	defer ticker.Stop()
+
+	var lastHeaderNumber uint64
+	for {
+		select {
+		case <-ticker.C:
+			header, err := borClient.HeaderByNumber(ctx, nil)
+			if err != nil {
+				cp.Logger.Error("Error while fetching latest header of checkpoint chain", "chainID", chain.ChainID, "error", err)
+				continue
+			}
+
+			if header.Number.Uint64() <= lastHeaderNumber {
+				continue
+			}
+			lastHeaderNumber = header.Number.Uint64()
+
+			headerBytes, err := header.MarshalJSON()
+			if err != nil {
+				cp.Logger.Error("Error marshalling header block", "error", err)
+				continue
+			}
+
+			cp.sendChainHeaderTask(chain.ChainID, string(headerBytes))
+		case <-ctx.Done():
+			cp.Logger.Info("Checkpoint chain header polling stopped", "chainID", chain.ChainID)
+			return
+		}
+	}
+}
+
+// sendChainHeaderTask queues header of checkpoint chain, delayed by tx confirmation time
+func (cp *CheckpointProcessor) sendChainHeaderTask(chainID string, headerBlockStr string) {
+	configParams, err := util.GetConfigManagerParams(cp.cliCtx)
+	if err != nil {
+		cp.Logger.Error("Error while fetching chain params", "error", err)
+		return
+	}
+
+	// create machinery task
+	signature := &tasks.Signature{
+		Name: "sendChainCheckpointToHeimdall",
+		Args: []tasks.Arg{
+			{
+				Type:  "string",
+				Value: chainID,
+			},
+			{
+				Type:  "string",
+				Value: headerBlockStr,
+			},
+		},
+	}
+	signature.RetryCount = util.TaskRetryCount
+
+	eta := time.Now().Add(configParams.TxConfirmationTime)
+	signature.ETA = &eta
+	if _, err := cp.queueConnector.Server.SendTask(signature); err != nil {
+		cp.Logger.Error("Error sending task", "taskName", signature.Name, "chainID", chainID, "error", err)
+	}
+}
+
 // sendCheckpointToHeimdall - handles headerblock from maticchain
+func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (err error) {
+	return cp.sendChainCheckpointToHeimdall("", headerBlockStr)
+}
+
+// sendChainCheckpointToHeimdall - handles headerblock of checkpoint chain, empty chain id for default chain
 // 1. check if i am the proposer for next checkpoint
 // 2. check if checkpoint has to be proposed for given headerblock
 // 3. if so, propose checkpoint to heimdall.
-func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (err error) {
+func (cp *CheckpointProcessor) sendChainCheckpointToHeimdall(chainID string, headerBlockStr string) (err error) {
 	var header = types.Header{}
 	if err := header.UnmarshalJSON([]byte(headerBlockStr)); err != nil {
 		cp.Logger.Error("Error while unmarshalling the header block", "error", err)
 		return err
 	}
 
-	cp.Logger.Info("Processing new header", "headerNumber", header.Number)
+	chain, err := cp.getCheckpointChain(chainID)
+	if err != nil {
+		return err
+	}
+
+	cp.Logger.Info("Processing new header", "headerNumber", header.Number, "chainID", chain.ChainID)
 	var isProposer bool
 	if isProposer, err = util.IsProposer(cp.cliCtx); err != nil {
 		cp.Logger.Error("Error checking isProposer in HeaderBlock handler", "error", err)
@@ -104,7 +216,7 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 	}
 
 	if isProposer {
-		expectedCheckpointState, err := cp.nextExpectedCheckpoint(header.Number.Uint64())
+		expectedCheckpointState, err := cp.nextExpectedCheckpoint(chain, header.Number.Uint64())
 		if err != nil {
 			cp.Logger.Error("Error while calculate next expected checkpoint", "error", err)
 			return err
@@ -113,7 +225,7 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 		end := expectedCheckpointState.newEnd
 		// TODO - add a check to see if this checkpoint has to be proposed or not.
 		// Fetch latest checkpoint from buffer. if expectedCheckpointState.newStart == start, don't send checkpoint
-		if err := cp.createAndSendCheckpointToHeimdall(start, end); err != nil {
+		if err := cp.createAndSendCheckpointToHeimdall(chain, start, end); err != nil {
 			cp.Logger.Error("Error sending checkpoint to heimdall", "error", err)
 			return err
 		}
@@ -152,7 +264,11 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, txHe
 
 	var startBlock uint64
 	var endBlock uint64
+	var chainID string
 	for _, attr := range event.Attributes {
+		if attr.Key == checkpointTypes.AttributeKeyChainID {
+			chainID = attr.Value
+		}
 		if attr.Key == checkpointTypes.AttributeKeyStartBlock {
 			startBlock, _ = strconv.ParseUint(attr.Value, 10, 64)
 		}
@@ -161,7 +277,12 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, txHe
 		}
 	}
 
-	shouldSend, err := cp.shouldSendCheckpoint(startBlock, endBlock)
+	chain, err := cp.getCheckpointChain(chainID)
+	if err != nil {
+		return err
+	}
+
+	shouldSend, err := cp.shouldSendCheckpoint(chain, startBlock, endBlock)
 	if err != nil {
 		return err
 	}
@@ -172,7 +293,7 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, txHe
 			cp.Logger.Error("Error decoding txHash while sending checkpoint to rootchain", "txHash", txHash, "error", err)
 			return err
 		}
-		if err := cp.createAndSendCheckpointToRootchain(startBlock, endBlock, txHeight, txHash); err != nil {
+		if err := cp.createAndSendCheckpointToRootchain(chain, startBlock, endBlock, txHeight, txHash); err != nil {
 			cp.Logger.Error("Error sending checkpoint to rootchain", "error", err)
 			return err
 		}
@@ -192,6 +313,17 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 		return err
 	}
 
+	if cp.isStaleLog(&log) {
+		return nil
+	}
+
+	// checkpoint chain is found by rootchain contract which emitted the event
+	chain, err := cp.getCheckpointChainByRootChain(log.Address)
+	if err != nil {
+		cp.Logger.Error("Error while finding checkpoint chain of rootchain event", "address", log.Address, "error", err)
+		return err
+	}
+
 	event := new(rootchain.RootchainNewHeaderBlock)
 	if err := helper.UnpackLog(cp.rootchainAbi, event, eventName, &log); err != nil {
 		cp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
@@ -207,13 +339,14 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 			"headerNumber", event.HeaderBlockId,
 			"txHash", hmTypes.BytesToHeimdallHash(log.TxHash.Bytes()),
 			"logIndex", uint64(log.Index),
+			"chainID", chain.ChainID,
 		)
 
 		// TODO - check if this ack is already processed on heimdall or not.
 		// TODO - check if i am the proposer of this ack or not.
 
 		// create msg checkpoint ack message
-		msg := checkpointTypes.NewMsgCheckpointAck(helper.GetFromAddress(cp.cliCtx), event.HeaderBlockId.Uint64(), hmTypes.BytesToHeimdallHash(log.TxHash.Bytes()), uint64(log.Index))
+		msg := checkpointTypes.NewMsgCheckpointAck(helper.GetFromAddress(cp.cliCtx), event.HeaderBlockId.Uint64(), hmTypes.BytesToHeimdallHash(log.TxHash.Bytes()), uint64(log.Index), chain.ChainID)
 
 		// return broadcast to heimdall
 		if err := cp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
@@ -254,10 +387,15 @@ func (cp *CheckpointProcessor) HandleCheckpointNoAck() {
 }
 
 // nextExpectedCheckpoint - fetched contract checkpoint state and returns the next probable checkpoint that needs to be sent
-func (cp *CheckpointProcessor) nextExpectedCheckpoint(latestChildBlock uint64) (*ContractCheckpoint, error) {
-	configParams, _ := util.GetConfigManagerParams(cp.cliCtx)
+func (cp *CheckpointProcessor) nextExpectedCheckpoint(chain chainmanagerTypes.CheckpointChain, latestChildBlock uint64) (*ContractCheckpoint, error) {
+	// checkpoint length is enforced by heimdall, so it is taken from on-chain params
+	checkpointParams, err := cp.getCheckpointParams()
+	if err != nil {
+		cp.Logger.Error("Error while fetching checkpoint params", "error", err)
+		return nil, err
+	}
 
-	rootChainInstance, err := cp.contractConnector.GetRootChainInstance(configParams.ChainParams.RootChainAddress.EthAddress())
+	rootChainInstance, err := cp.contractConnector.GetRootChainInstance(chain.RootChainAddress.EthAddress())
 	if err != nil {
 		return nil, err
 	}
@@ -292,13 +430,13 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(latestChildBlock uint64) (
 	diff := latestChildBlock - start + 1
 	// process if diff > 0 (positive)
 	if diff > 0 {
-		expectedDiff := diff - diff%helper.GetConfig().AvgCheckpointLength
+		expectedDiff := diff - diff%checkpointParams.AvgCheckpointLength
 		if expectedDiff > 0 {
 			expectedDiff = expectedDiff - 1
 		}
 		// cap with max checkpoint length
-		if expectedDiff > helper.GetConfig().MaxCheckpointLength-1 {
-			expectedDiff = helper.GetConfig().MaxCheckpointLength - 1
+		if expectedDiff > checkpointParams.MaxCheckpointLength-1 {
+			expectedDiff = checkpointParams.MaxCheckpointLength - 1
 		}
 		// get end result
 		end = expectedDiff + start
@@ -310,13 +448,17 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(latestChildBlock uint64) (
 	}
 
 	// Handle when block producers go down
-	if end == 0 || end == start || (0 < diff && diff < helper.GetConfig().AvgCheckpointLength) {
+	if end == 0 || end == start || (0 < diff && diff < checkpointParams.AvgCheckpointLength) {
 		cp.Logger.Debug("Fetching last header block to calculate time")
 
 		currentTime := time.Now().UTC().Unix()
-		defaultForcePushInterval := helper.GetConfig().MaxCheckpointLength * 2 // in seconds (1024 * 2 seconds)
+		defaultForcePushInterval := checkpointParams.MaxCheckpointLength * 2 // in seconds (1024 * 2 seconds)
 		if currentTime-int64(lastCheckpointTime) > int64(defaultForcePushInterval) {
 			end = latestChildBlock
+			// force pushed checkpoint must still fit in max checkpoint length
+			if end-start+1 > checkpointParams.MaxCheckpointLength {
+				end = start + checkpointParams.MaxCheckpointLength - 1
+			}
 			cp.Logger.Info("Force push checkpoint",
 				"currentTime", currentTime,
 				"lastCheckpointTime", lastCheckpointTime,
@@ -338,8 +480,8 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(latestChildBlock uint64) (
 }
 
 // sendCheckpointToHeimdall - creates checkpoint msg and broadcasts to heimdall
-func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(start uint64, end uint64) error {
-	cp.Logger.Debug("Initiating checkpoint to Heimdall", "start", start, "end", end)
+func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(chain chainmanagerTypes.CheckpointChain, start uint64, end uint64) error {
+	cp.Logger.Debug("Initiating checkpoint to Heimdall", "start", start, "end", end, "chainID", chain.ChainID)
 
 	if end == 0 || start >= end {
 		cp.Logger.Info("Waiting for blocks or invalid start end formation", "start", start, "end", end)
@@ -347,7 +489,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(start uint64, e
 	}
 
 	// Get root hash
-	root, err := checkpointTypes.GetHeaders(start, end)
+	root, err := checkpointTypes.GetHeaders(chain.BorChainID, start, end)
 	if err != nil {
 		return err
 	}
@@ -364,6 +506,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(start uint64, e
 		"end", end,
 		"root", hmTypes.BytesToHeimdallHash(root),
 		"accountRoot", accountRootHash,
+		"chainID", chain.ChainID,
 	)
 
 	// create and send checkpoint message
@@ -373,6 +516,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(start uint64, e
 		end,
 		hmTypes.BytesToHeimdallHash(root),
 		accountRootHash,
+		chain.ChainID,
 	)
 
 	// return broadcast to heimdall
@@ -386,7 +530,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(start uint64, e
 
 // createAndSendCheckpointToRootchain prepares the data required for rootchain checkpoint submission
 // and sends a transaction to rootchain
-func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(start uint64, end uint64, height int64, txHash []byte) error {
+func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(chain chainmanagerTypes.CheckpointChain, start uint64, end uint64, height int64, txHash []byte) error {
 	cp.Logger.Info("Preparing checkpoint to be pushed on chain", "height", height, "txHash", hmTypes.BytesToHeimdallHash(txHash), "start", start, "end", end)
 	// proof
 	tx, err := helper.QueryTxWithProof(cp.cliCtx, txHash)
@@ -402,24 +546,35 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(start uint64,
 		return err
 	}
 
-	shouldSend, err := cp.shouldSendCheckpoint(start, end)
+	shouldSend, err := cp.shouldSendCheckpoint(chain, start, end)
 	if err != nil {
 		return err
 	}
 
 	if shouldSend {
-		configParams, _ := util.GetConfigManagerParams(cp.cliCtx)
-		rootChainAddress := configParams.ChainParams.RootChainAddress.EthAddress()
-		// root chain instance
-		rootChainInstance, err := cp.contractConnector.GetRootChainInstance(rootChainAddress)
+		rootChainAddress := chain.RootChainAddress.EthAddress()
+
+		// submitHeaderBlock call data
+		data, err := cp.contractConnector.PackCheckpoint(helper.GetVoteBytes(votes, chainID), sigs, tx.Tx[authTypes.PulpHashLength:])
 		if err != nil {
-			panic(err)
+			return err
+		}
+
+		msg := ethereum.CallMsg{
+			To:   &rootChainAddress,
+			Data: data,
 		}
 
-		if err := cp.contractConnector.SendCheckpoint(helper.GetVoteBytes(votes, chainID), sigs, tx.Tx[authTypes.PulpHashLength:], rootChainAddress, rootChainInstance); err != nil {
-			cp.Logger.Info("Error submitting checkpoint to rootchain", "error", err)
+		// broadcast to rootchain and wait for receipt, failed submission is retried with the task
+		ctx, cancel := context.WithTimeout(context.Background(), checkpointTxTimeout)
+		defer cancel()
+
+		receipt, err := cp.txBroadcaster.BroadcastToRootchainAndWait(ctx, msg)
+		if err != nil {
+			cp.Logger.Error("Error submitting checkpoint to rootchain", "error", err)
 			return err
 		}
+		cp.Logger.Info("Checkpoint submitted to rootchain", "txHash", receipt.TxHash, "start", start, "end", end)
 	}
 
 	return nil
@@ -490,23 +645,23 @@ func (cp *CheckpointProcessor) checkIfNoAckIsRequired(lastCreatedAt int64) (bool
 		index = 1
 	}
 
+	params, err := cp.getCheckpointParams()
+	if err != nil {
+		return false, uint64(index)
+	}
+
 	checkpointCreationTime := time.Unix(lastCreatedAt, 0)
 	currentTime := time.Now().UTC()
 	timeDiff := currentTime.Sub(checkpointCreationTime)
 	// check if last checkpoint was < NoACK wait time
-	if timeDiff.Seconds() >= helper.GetConfig().NoACKWaitTime.Seconds() && index == 0 {
-		index = math.Floor(timeDiff.Seconds() / helper.GetConfig().NoACKWaitTime.Seconds())
+	if timeDiff.Seconds() >= params.NoACKWaitTime.Seconds() && index == 0 {
+		index = math.Floor(timeDiff.Seconds() / params.NoACKWaitTime.Seconds())
 	}
 
 	if index == 0 {
 		return false, uint64(index)
 	}
 
-	params, err := cp.getCheckpointParams()
-	if err != nil {
-		return false, uint64(index)
-	}
-
 	// check if difference between no-ack time and current time
 	lastNoAck := cp.getLastNoAckTime()
 
@@ -557,10 +712,8 @@ func (cp *CheckpointProcessor) getCheckpointParams() (*checkpointTypes.Params, e
 }
 
 // shouldSendCheckpoint checks if checkpoint with given start,end should be sent to rootchain or not.
-func (cp *CheckpointProcessor) shouldSendCheckpoint(start uint64, end uint64) (shouldSend bool, err error) {
-	configParams, _ := util.GetConfigManagerParams(cp.cliCtx)
-
-	rootChainInstance, err := cp.contractConnector.GetRootChainInstance(configParams.ChainParams.RootChainAddress.EthAddress())
+func (cp *CheckpointProcessor) shouldSendCheckpoint(chain chainmanagerTypes.CheckpointChain, start uint64, end uint64) (shouldSend bool, err error) {
+	rootChainInstance, err := cp.contractConnector.GetRootChainInstance(chain.RootChainAddress.EthAddress())
 	if err != nil {
 		cp.Logger.Info("Error while creating rootchain instance", err)
 
@@ -591,8 +744,53 @@ func (cp *CheckpointProcessor) shouldSendCheckpoint(start uint64, end uint64) (s
 	return
 }
 
+// getCheckpointChain returns checkpoint chain by id, empty id for default chain
+func (cp *CheckpointProcessor) getCheckpointChain(chainID string) (chainmanagerTypes.CheckpointChain, error) {
+	configParams, err := util.GetConfigManagerParams(cp.cliCtx)
+	if err != nil {
+		cp.Logger.Error("Error while fetching chain params", "error", err)
+		return chainmanagerTypes.CheckpointChain{}, err
+	}
+
+	chain, ok := configParams.ChainParams.GetCheckpointChain(chainID)
+	if !ok {
+		cp.Logger.Error("Unknown checkpoint chain", "chainID", chainID)
+		return chain, fmt.Errorf("unknown checkpoint chain %v", chainID)
+	}
+
+	return bridgeCheckpointChain(configParams.ChainParams, chain), nil
+}
+
+// getCheckpointChainByRootChain returns checkpoint chain submitted to given rootchain contract
+func (cp *CheckpointProcessor) getCheckpointChainByRootChain(rootChainAddress common.Address) (chainmanagerTypes.CheckpointChain, error) {
+	configParams, err := util.GetConfigManagerParams(cp.cliCtx)
+	if err != nil {
+		return chainmanagerTypes.CheckpointChain{}, err
+	}
+
+	for _, chain := range configParams.ChainParams.GetAllCheckpointChains() {
+		if chain.RootChainAddress.EthAddress() == rootChainAddress {
+			return bridgeCheckpointChain(configParams.ChainParams, chain), nil
+		}
+	}
+
+	return chainmanagerTypes.CheckpointChain{}, fmt.Errorf("no checkpoint chain for rootchain %v", rootChainAddress.Hex())
+}
+
+// bridgeCheckpointChain clears chain ids of default chain, as its checkpoint msgs keep rlp layout
+// without chain id (tx bytes are submitted to rootchain) and its headers come from matic chain client
+func bridgeCheckpointChain(chainParams chainmanagerTypes.ChainParams, chain chainmanagerTypes.CheckpointChain) chainmanagerTypes.CheckpointChain {
+	if chain.ChainID == chainParams.BorChainID {
+		chain.ChainID = ""
+		chain.BorChainID = ""
+	}
+	return chain
+}
+
 // Stop stops all necessary go routines
 func (cp *CheckpointProcessor) Stop() {
 	// cancel No-Ack polling
 	cp.cancelNoACKPolling()
+	// cancel checkpoint chain header polling
+	cp.cancelChainPolling()
 }
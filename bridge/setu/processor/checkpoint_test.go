@@ -0,0 +1,68 @@
+package processor
+
+import (
+	"testing"
+
+	"github.com/maticnetwork/bor/rlp"
+	"github.com/stretchr/testify/require"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// legacyMsgCheckpoint is checkpoint msg layout expected by rootchain before checkpoint chains
+type legacyMsgCheckpoint struct {
+	Proposer        hmTypes.HeimdallAddress
+	StartBlock      uint64
+	EndBlock        uint64
+	RootHash        hmTypes.HeimdallHash
+	AccountRootHash hmTypes.HeimdallHash
+}
+
+// legacyMsgCheckpointAck is checkpoint ack msg layout before checkpoint chains
+type legacyMsgCheckpointAck struct {
+	From        hmTypes.HeimdallAddress
+	HeaderBlock uint64
+	TxHash      hmTypes.HeimdallHash
+	LogIndex    uint64
+}
+
+// txBytes encodes tx of msg the way it is included in heimdall block, without pulp prefix
+func txBytes(t *testing.T, msg interface{}) []byte {
+	msgBytes, err := rlp.EncodeToBytes(msg)
+	require.NoError(t, err)
+
+	bz, err := rlp.EncodeToBytes(authTypes.StdTxRaw{
+		Msg:       msgBytes,
+		Signature: authTypes.StdSignature([]byte{1, 2, 3}),
+		Memo:      "",
+	})
+	require.NoError(t, err)
+	return bz
+}
+
+func TestDefaultChainCheckpointMsgLegacyRLP(t *testing.T) {
+	chainParams := chainmanagerTypes.DefaultParams().ChainParams
+	proposer := hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000001")
+	root, accountRoot := hmTypes.HexToHeimdallHash("0x01"), hmTypes.HexToHeimdallHash("0x02")
+
+	// default chain is built as bridge builds it from chain params
+	chain := bridgeCheckpointChain(chainParams, chainParams.DefaultCheckpointChain())
+	require.Empty(t, chain.ChainID)
+	require.Empty(t, chain.BorChainID)
+
+	msg := checkpointTypes.NewMsgCheckpointBlock(proposer, 256, 511, root, accountRoot, chain.ChainID)
+	require.Equal(t, txBytes(t, legacyMsgCheckpoint{proposer, 256, 511, root, accountRoot}), txBytes(t, msg))
+
+	ack := checkpointTypes.NewMsgCheckpointAck(proposer, 10000, root, 1, chain.ChainID)
+	require.Equal(t, txBytes(t, legacyMsgCheckpointAck{proposer, 10000, root, 1}), txBytes(t, ack))
+
+	// other checkpoint chains keep their chain id
+	other := chainmanagerTypes.CheckpointChain{ChainID: "15002", BorChainID: "15002"}
+	require.Equal(t, other, bridgeCheckpointChain(chainParams, other))
+
+	msg = checkpointTypes.NewMsgCheckpointBlock(proposer, 256, 511, root, accountRoot, other.ChainID)
+	require.NotEqual(t, txBytes(t, legacyMsgCheckpoint{proposer, 256, 511, root, accountRoot}), txBytes(t, msg))
+}
@@ -56,6 +56,10 @@ func (cp *ClerkProcessor) sendStateSyncedToHeimdall(eventName string, logBytes s
 		return err
 	}
 
+	if cp.isStaleLog(&vLog) {
+		return nil
+	}
+
 	configParams, _ := util.GetConfigManagerParams(cp.cliCtx)
 
 	event := new(statesender.StatesenderStateSynced)
@@ -2,7 +2,6 @@ package processor
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/common"
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 
@@ -28,8 +27,25 @@ type ProcessorService struct {
 
 const (
 	processorServiceStr = "processor-service"
+
+	CheckpointProcessorStr = "checkpoint"
+	StakingProcessorStr    = "staking"
+	ClerkProcessorStr      = "clerk"
+	FeeProcessorStr        = "fee"
+	SpanProcessorStr       = "span"
+	SideTxProcessorStr     = "sidetx"
 )
 
+// ProcessorNames are names of all processors, accepted by --only flag
+var ProcessorNames = []string{
+	CheckpointProcessorStr,
+	StakingProcessorStr,
+	ClerkProcessorStr,
+	FeeProcessorStr,
+	SpanProcessorStr,
+	SideTxProcessorStr,
+}
+
 // NewProcessorService returns new service object for processing queue msg
 func NewProcessorService(
 	cdc *codec.Codec,
@@ -58,61 +74,46 @@ func NewProcessorService(
 
 	// initialize checkpoint processor
 	checkpointProcessor := NewCheckpointProcessor(&contractCaller.RootChainABI)
-	checkpointProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "checkpoint", checkpointProcessor)
+	checkpointProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, CheckpointProcessorStr, checkpointProcessor)
 
 	// initialize fee processor
 	feeProcessor := NewFeeProcessor(&contractCaller.StakingInfoABI)
-	feeProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "fee", feeProcessor)
+	feeProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, FeeProcessorStr, feeProcessor)
 
 	// initialize staking processor
 	stakingProcessor := NewStakingProcessor(&contractCaller.StakingInfoABI)
-	stakingProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "staking", stakingProcessor)
+	stakingProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, StakingProcessorStr, stakingProcessor)
 
 	// initialize clerk processor
 	clerkProcessor := NewClerkProcessor(&contractCaller.StateSenderABI)
-	clerkProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "clerk", clerkProcessor)
+	clerkProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, ClerkProcessorStr, clerkProcessor)
 
 	// initialize span processor
 	spanProcessor := &SpanProcessor{}
-	spanProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "span", spanProcessor)
+	spanProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, SpanProcessorStr, spanProcessor)
+
+	// initialize side-tx processor
+	sideTxProcessor := NewSideTxProcessor()
+	sideTxProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, SideTxProcessorStr, sideTxProcessor)
 
 	//
 	// Select processors
 	//
 
-	// add into processor list
-	startAll := viper.GetBool("all")
-	onlyServices := viper.GetStringSlice("only")
-
-	if startAll {
-		processorService.processors = append(processorService.processors,
-			checkpointProcessor,
-			stakingProcessor,
-			clerkProcessor,
-			feeProcessor,
-			spanProcessor,
-		)
-	} else {
-		for _, service := range onlyServices {
-			switch service {
-			case "checkpoint":
-				processorService.processors = append(processorService.processors, checkpointProcessor)
-			case "staking":
-				processorService.processors = append(processorService.processors, stakingProcessor)
-			case "clerk":
-				processorService.processors = append(processorService.processors, clerkProcessor)
-			case "fee":
-				processorService.processors = append(processorService.processors, feeProcessor)
-			case "span":
-				processorService.processors = append(processorService.processors, spanProcessor)
-			}
+	// add selected processors into processor list
+	for _, processor := range []Processor{
+		checkpointProcessor,
+		stakingProcessor,
+		clerkProcessor,
+		feeProcessor,
+		spanProcessor,
+		sideTxProcessor,
+	} {
+		if util.IsServiceSelected(processor.String(), util.ProcessorsServiceGroup) {
+			processorService.processors = append(processorService.processors, processor)
 		}
 	}
 
-	if len(processorService.processors) == 0 {
-		panic("No processors selected. Use --all or --only <coma-seprated processors>")
-	}
-
 	return processorService
 }
 
@@ -0,0 +1,160 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+	sidetxTypes "github.com/maticnetwork/heimdall/sidetx/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+// SideTxProcessor - verifies pending side-txs and votes on them
+type SideTxProcessor struct {
+	BaseProcessor
+
+	// side-txs voted by this bridge, kept until they are not pending anymore
+	voted map[string]bool
+
+	cancelSideTxService context.CancelFunc
+}
+
+// NewSideTxProcessor creates side-tx processor
+func NewSideTxProcessor() *SideTxProcessor {
+	return &SideTxProcessor{
+		voted: make(map[string]bool),
+	}
+}
+
+// Start starts polling for pending side-txs
+func (sp *SideTxProcessor) Start() error {
+	sp.Logger.Info("Starting")
+
+	// create cancellable context
+	sideTxCtx, cancelSideTxService := context.WithCancel(context.Background())
+
+	sp.cancelSideTxService = cancelSideTxService
+
+	// start polling for side-txs
+	sp.Logger.Info("Start polling for side-txs", "pollInterval", helper.GetConfig().SideTxPollingInterval)
+	go sp.startPolling(sideTxCtx, helper.GetConfig().SideTxPollingInterval)
+	return nil
+}
+
+// RegisterTasks - nil
+func (sp *SideTxProcessor) RegisterTasks() {
+
+}
+
+// startPolling - polls heimdall for pending side-txs
+func (sp *SideTxProcessor) startPolling(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	// stop ticker when everything done
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			sp.checkAndVote()
+		case <-ctx.Done():
+			sp.Logger.Info("Polling stopped")
+			return
+		}
+	}
+}
+
+// checkAndVote verifies pending side-txs not voted by this validator yet and votes on them
+func (sp *SideTxProcessor) checkAndVote() {
+	// only current validators can vote
+	if isCurrentValidator, _ := util.CalculateTaskDelay(sp.cliCtx); !isCurrentValidator {
+		return
+	}
+
+	sideTxs, err := sp.getPendingSideTxs()
+	if err != nil {
+		sp.Logger.Error("Error while fetching pending side-txs", "error", err)
+		return
+	}
+
+	signer := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
+	pending := make(map[string]bool, len(sideTxs))
+	for _, sideTx := range sideTxs {
+		hash := sideTx.Hash.String()
+		pending[hash] = true
+
+		// vote is broadcasted async, so it may not be included yet
+		if sp.voted[hash] || sideTx.HasSignerVoted(signer) {
+			continue
+		}
+
+		result, err := sp.verifySideTx(sideTx.Hash)
+		if err != nil {
+			sp.Logger.Error("Error while verifying side-tx", "hash", hash, "error", err)
+			continue
+		}
+
+		// external data is not available yet (eg. tx is not confirmed), verify again on next poll
+		if result == hmModule.VoteSkip {
+			sp.Logger.Debug("Side-tx can't be verified yet", "hash", hash)
+			continue
+		}
+
+		sp.Logger.Info("✅ Voting on side-tx", "hash", hash, "route", sideTx.Msg.Route(), "type", sideTx.Msg.Type(), "vote", result)
+
+		msg := sidetxTypes.NewMsgSideTxVote(signer, sideTx.Hash, result)
+		if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+			sp.Logger.Error("Error while broadcasting side-tx vote to heimdall", "hash", hash, "error", err)
+			continue
+		}
+
+		sp.voted[hash] = true
+	}
+
+	// forget side-txs which are concluded or expired
+	for hash := range sp.voted {
+		if !pending[hash] {
+			delete(sp.voted, hash)
+		}
+	}
+}
+
+// getPendingSideTxs fetches side-txs waiting for votes
+func (sp *SideTxProcessor) getPendingSideTxs() ([]sidetxTypes.SideTx, error) {
+	result, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(util.PendingSideTxsURL))
+	if err != nil {
+		return nil, err
+	}
+
+	// side-txs contain msgs of other modules, app codec is needed to decode them
+	var sideTxs []sidetxTypes.SideTx
+	if err := sp.cliCtx.Codec.UnmarshalJSON(result.Result, &sideTxs); err != nil {
+		return nil, err
+	}
+
+	return sideTxs, nil
+}
+
+// verifySideTx verifies side-tx using heimdall node's side handler
+func (sp *SideTxProcessor) verifySideTx(hash hmTypes.HeimdallHash) (hmModule.SideTxResult, error) {
+	result, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(fmt.Sprintf(util.VerifySideTxURL, hash.String())))
+	if err != nil {
+		return hmModule.VoteSkip, err
+	}
+
+	var vote hmModule.SideTxResult
+	if err := json.Unmarshal(result.Result, &vote); err != nil {
+		return hmModule.VoteSkip, err
+	}
+
+	return vote, nil
+}
+
+// Stop stops all necessary go routines
+func (sp *SideTxProcessor) Stop() {
+	// cancel side-tx polling
+	sp.cancelSideTxService()
+}
@@ -1,7 +1,11 @@
 package processor
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
@@ -14,10 +18,16 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+const (
+	stakingReconcileLastBlockKey = "staking-reconcile-last-block" // storage key
+)
+
 // StakingProcessor - process staking related events
 type StakingProcessor struct {
 	BaseProcessor
 	stakingInfoAbi *abi.ABI
+
+	cancelReconcileService context.CancelFunc
 }
 
 // NewStakingProcessor - add  abi to staking processor
@@ -31,9 +41,30 @@ func NewStakingProcessor(stakingInfoAbi *abi.ABI) *StakingProcessor {
 // Start starts new block subscription
 func (sp *StakingProcessor) Start() error {
 	sp.Logger.Info("Starting")
+
+	interval := helper.GetConfig().StakingReconcileInterval
+	if interval <= 0 {
+		return nil
+	}
+
+	// create cancellable context
+	reconcileCtx, cancelReconcileService := context.WithCancel(context.Background())
+
+	sp.cancelReconcileService = cancelReconcileService
+
+	// start polling for staking reconciliation
+	sp.Logger.Info("Start polling for staking reconciliation", "pollInterval", interval, "fix", helper.GetConfig().StakingReconcileFix)
+	go sp.startPolling(reconcileCtx, interval)
 	return nil
 }
 
+// Stop stops staking reconciliation
+func (sp *StakingProcessor) Stop() {
+	if sp.cancelReconcileService != nil {
+		sp.cancelReconcileService()
+	}
+}
+
 // RegisterTasks - Registers staking tasks with machinery
 func (sp *StakingProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering staking related tasks")
@@ -50,6 +81,10 @@ func (sp *StakingProcessor) sendValidatorJoinToHeimdall(eventName string, logByt
 		return err
 	}
 
+	if sp.isStaleLog(&vLog) {
+		return nil
+	}
+
 	event := new(stakinginfo.StakinginfoStaked)
 	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
 		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
@@ -119,6 +154,10 @@ func (sp *StakingProcessor) sendUnstakeInitToHeimdall(eventName string, logBytes
 		return err
 	}
 
+	if sp.isStaleLog(&vLog) {
+		return nil
+	}
+
 	event := new(stakinginfo.StakinginfoUnstakeInit)
 	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
 		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
@@ -171,6 +210,10 @@ func (sp *StakingProcessor) sendStakeUpdateToHeimdall(eventName string, logBytes
 		return err
 	}
 
+	if sp.isStaleLog(&vLog) {
+		return nil
+	}
+
 	event := new(stakinginfo.StakinginfoStakeUpdate)
 	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
 		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
@@ -218,6 +261,10 @@ func (sp *StakingProcessor) sendSignerChangeToHeimdall(eventName string, logByte
 		return err
 	}
 
+	if sp.isStaleLog(&vLog) {
+		return nil
+	}
+
 	event := new(stakinginfo.StakinginfoSignerChange)
 	if err := helper.UnpackLog(sp.stakingInfoAbi, event, eventName, &vLog); err != nil {
 		sp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
@@ -292,3 +339,201 @@ func (sp *StakingProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string,
 
 	return status, nil
 }
+
+// startPolling - periodically compares heimdall validators with staking contracts
+func (sp *StakingProcessor) startPolling(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	// stop ticker when everything done
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			sp.reconcileValidators(ctx)
+		case <-ctx.Done():
+			sp.Logger.Info("Polling stopped")
+			return
+		}
+	}
+}
+
+// reconcileValidators reports validators out of sync with staking contracts,
+// and replays missed staking events if enabled
+func (sp *StakingProcessor) reconcileValidators(ctx context.Context) {
+	// events of unconfirmed rootchain blocks may not be processed by heimdall yet
+	endBlock, err := sp.getReconcileEndBlock()
+	if err != nil {
+		sp.Logger.Error("Error while fetching latest confirmed rootchain block", "error", err)
+		return
+	}
+
+	validators, err := sp.getAllValidators()
+	if err != nil {
+		sp.Logger.Error("Error while fetching validators", "error", err)
+		return
+	}
+
+	configParams, err := util.GetConfigManagerParams(sp.cliCtx)
+	if err != nil {
+		sp.Logger.Error("Error while fetching chain manager params", "error", err)
+		return
+	}
+	chainParams := configParams.ChainParams
+
+	diffs, err := stakingTypes.ReconcileValidators(
+		&sp.contractConnector,
+		chainParams.StakingInfoAddress.EthAddress(),
+		chainParams.StakingManagerAddress.EthAddress(),
+		validators,
+	)
+	if err != nil {
+		sp.Logger.Error("Error while comparing validators with staking contracts", "error", err)
+		return
+	}
+
+	// signer rotations staged until next checkpoint ack are not missed events
+	diffs = stakingTypes.SkipPendingSignerDiffs(diffs, sp.getPendingSignerUpdates(diffs))
+
+	if len(diffs) == 0 {
+		sp.Logger.Debug("Validators are in sync with staking contracts", "validators", len(validators))
+
+		// events up to end block are processed, later runs search missed events after it
+		sp.storageClient.Put([]byte(stakingReconcileLastBlockKey), []byte(strconv.FormatUint(endBlock, 10)), nil)
+		return
+	}
+
+	for _, diff := range diffs {
+		sp.Logger.Error("Validator out of sync with staking contracts", "validatorID", diff.ID, "field", diff.Field, "heimdall", diff.Heimdall, "onchain", diff.Onchain)
+	}
+
+	if !helper.GetConfig().StakingReconcileFix {
+		return
+	}
+
+	// only current validators replay missed events
+	isCurrentValidator, delay := util.CalculateTaskDelay(sp.cliCtx)
+	if !isCurrentValidator {
+		return
+	}
+
+	startBlock := sp.getReconcileStartBlock()
+	if startBlock > endBlock {
+		sp.Logger.Debug("No confirmed rootchain blocks to search staking events", "startBlock", startBlock, "endBlock", endBlock)
+		return
+	}
+
+	correctingMsgs, err := stakingTypes.GetCorrectingMsgs(
+		&sp.contractConnector,
+		chainParams.StakingInfoAddress.EthAddress(),
+		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		diffs,
+		startBlock,
+		endBlock,
+	)
+	if err != nil {
+		sp.Logger.Error("Error while fetching staking events to replay", "error", err)
+		return
+	}
+
+	if len(correctingMsgs) == 0 {
+		return
+	}
+
+	// validators replay in turn, events replayed by earlier validators are old txs by then
+	select {
+	case <-time.After(delay):
+	case <-ctx.Done():
+		return
+	}
+
+	for _, correctingMsg := range correctingMsgs {
+		// latest event is processed already, difference is not caused by missed event
+		if isOld, _ := sp.isOldTx(sp.cliCtx, correctingMsg.TxHash.EthHash().String(), correctingMsg.LogIndex); isOld {
+			continue
+		}
+
+		sp.Logger.Info("✅ Replaying missed staking event",
+			"type", correctingMsg.Msg.Type(),
+			"validatorID", correctingMsg.Diff.ID,
+			"field", correctingMsg.Diff.Field,
+			"txHash", correctingMsg.TxHash,
+			"logIndex", correctingMsg.LogIndex,
+		)
+
+		if err := sp.txBroadcaster.BroadcastToHeimdall(correctingMsg.Msg); err != nil {
+			sp.Logger.Error("Error while broadcasting staking msg to heimdall", "validatorID", correctingMsg.Diff.ID, "error", err)
+		}
+	}
+}
+
+// getReconcileStartBlock returns rootchain block to search missed staking events from,
+// last block of reconciliation without diffs or configured start block, whichever is later
+func (sp *StakingProcessor) getReconcileStartBlock() uint64 {
+	startBlock := helper.GetConfig().StakingReconcileStartBlock
+
+	lastBlockBytes, err := sp.storageClient.Get([]byte(stakingReconcileLastBlockKey), nil)
+	if err != nil {
+		return startBlock
+	}
+
+	if lastBlock, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil && lastBlock > startBlock {
+		startBlock = lastBlock
+	}
+
+	return startBlock
+}
+
+// getReconcileEndBlock returns latest rootchain block with enough confirmations
+func (sp *StakingProcessor) getReconcileEndBlock() (uint64, error) {
+	latest, err := sp.contractConnector.GetMainChainBlock(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	confirmations := helper.GetConfig().MainchainConfirmations
+	if latest.Number.Uint64() < confirmations {
+		return 0, nil
+	}
+
+	return latest.Number.Uint64() - confirmations, nil
+}
+
+// getPendingSignerUpdates fetches signer rotations staged for validators with signer diffs
+func (sp *StakingProcessor) getPendingSignerUpdates(diffs []stakingTypes.ValidatorDiff) []stakingTypes.PendingSignerUpdate {
+	updates := make([]stakingTypes.PendingSignerUpdate, 0)
+	for _, diff := range diffs {
+		if diff.Field != stakingTypes.DiffFieldSigner {
+			continue
+		}
+
+		// not found if no signer rotation is staged for validator
+		result, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(fmt.Sprintf(util.PendingSignerUpdateURL, diff.ID)))
+		if err != nil {
+			continue
+		}
+
+		var update stakingTypes.PendingSignerUpdate
+		if err := json.Unmarshal(result.Result, &update); err != nil {
+			sp.Logger.Error("Error unmarshalling pending signer update", "validatorID", diff.ID, "error", err)
+			continue
+		}
+		updates = append(updates, update)
+	}
+
+	return updates
+}
+
+// getAllValidators fetches all validators in heimdall state
+func (sp *StakingProcessor) getAllValidators() ([]*hmTypes.Validator, error) {
+	result, err := helper.FetchFromAPI(sp.cliCtx, helper.GetHeimdallServerEndpoint(util.AllValidatorsURL))
+	if err != nil {
+		return nil, err
+	}
+
+	var validators []*hmTypes.Validator
+	if err := json.Unmarshal(result.Result, &validators); err != nil {
+		return nil, err
+	}
+
+	return validators, nil
+}
@@ -0,0 +1,82 @@
+package queue
+
+import (
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/streadway/amqp"
+
+	amqpBackend "github.com/RichardKnop/machinery/v1/backends/amqp"
+	backendsiface "github.com/RichardKnop/machinery/v1/backends/iface"
+	amqpBroker "github.com/RichardKnop/machinery/v1/brokers/amqp"
+	brokersiface "github.com/RichardKnop/machinery/v1/brokers/iface"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// AMQPBackend uses AMQP server (eg. RabbitMQ) as task broker and result backend
+type AMQPBackend struct {
+	dialer string
+	cnf    *config.Config
+}
+
+// NewAMQPBackend creates amqp backend after checking that server is reachable
+func NewAMQPBackend(dialer string) (*AMQPBackend, error) {
+	// amqp dialer
+	conn, err := amqp.Dial(dialer)
+	if err != nil {
+		return nil, err
+	}
+	conn.Close()
+
+	var cnf = &config.Config{
+		Broker:        dialer,
+		DefaultQueue:  QueueName,
+		ResultBackend: dialer,
+		AMQP: &config.AMQPConfig{
+			Exchange:     "machinery_exchange",
+			ExchangeType: "direct",
+			BindingKey:   "machinery_task",
+		},
+	}
+
+	return &AMQPBackend{
+		dialer: dialer,
+		cnf:    cnf,
+	}, nil
+}
+
+// Config returns machinery config
+func (ab *AMQPBackend) Config() *config.Config {
+	return ab.cnf
+}
+
+// Broker returns amqp broker
+func (ab *AMQPBackend) Broker() brokersiface.Broker {
+	return amqpBroker.New(ab.cnf)
+}
+
+// ResultBackend returns amqp result backend
+func (ab *AMQPBackend) ResultBackend() backendsiface.Backend {
+	return amqpBackend.New(ab.cnf)
+}
+
+// Purge purges machinery task queue
+func (ab *AMQPBackend) Purge() error {
+	conn, err := amqp.Dial(ab.dialer)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	channel, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer channel.Close()
+
+	_, err = channel.QueuePurge(QueueName, false)
+	return err
+}
+
+func (ab *AMQPBackend) String() string {
+	return helper.AMQPQueueBackend
+}
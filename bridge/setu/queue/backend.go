@@ -0,0 +1,48 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/spf13/viper"
+
+	backendsiface "github.com/RichardKnop/machinery/v1/backends/iface"
+	brokersiface "github.com/RichardKnop/machinery/v1/brokers/iface"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// Backend provides machinery broker and result backend for bridge tasks
+type Backend interface {
+	// Config returns machinery config for the backend
+	Config() *config.Config
+
+	// Broker returns task broker
+	Broker() brokersiface.Broker
+
+	// ResultBackend returns task result backend
+	ResultBackend() backendsiface.Backend
+
+	// Purge removes all queued tasks
+	Purge() error
+
+	String() string
+}
+
+// NewBackend creates queue backend selected in heimdall configuration
+func NewBackend(conf helper.Configuration) (Backend, error) {
+	switch conf.QueueBackend {
+	case "", helper.AMQPQueueBackend:
+		return NewAMQPBackend(conf.AmqpURL)
+	case helper.LevelDBQueueBackend:
+		db := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+		if db == nil {
+			return nil, errors.New("Unable to open bridge db for leveldb queue backend")
+		}
+		return NewLevelDBBackend(db), nil
+	default:
+		return nil, fmt.Errorf("Invalid queue backend: %v", conf.QueueBackend)
+	}
+}
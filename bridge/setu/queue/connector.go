@@ -1,18 +1,19 @@
 package queue
 
 import (
-	"github.com/streadway/amqp"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/RichardKnop/machinery/v1"
-	"github.com/RichardKnop/machinery/v1/config"
+	backendsiface "github.com/RichardKnop/machinery/v1/backends/iface"
 
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 )
 
 type QueueConnector struct {
-	logger log.Logger
-	Server *machinery.Server
+	logger      log.Logger
+	Server      *machinery.Server
+	Backend     Backend
+	DeadLetters *DeadLetterStore
 }
 
 const (
@@ -20,33 +21,27 @@ const (
 	QueueName = "machinery_tasks"
 )
 
-func NewQueueConnector(dialer string) *QueueConnector {
-	// amqp dialer
-	_, err := amqp.Dial(dialer)
-	if err != nil {
-		panic(err)
+// NewQueueConnector creates machinery server on top of given queue backend.
+// Tasks failing after all retries are recorded in deadLetters.
+func NewQueueConnector(backend Backend, deadLetters *DeadLetterStore) *QueueConnector {
+	var resultBackend backendsiface.Backend = &metricsBackend{
+		Backend: backend.ResultBackend(),
 	}
-
-	var cnf = &config.Config{
-		Broker:        dialer,
-		DefaultQueue:  QueueName,
-		ResultBackend: dialer,
-		AMQP: &config.AMQPConfig{
-			Exchange:     "machinery_exchange",
-			ExchangeType: "direct",
-			BindingKey:   "machinery_task",
-		},
+	if deadLetters != nil {
+		resultBackend = &deadLetterBackend{
+			Backend: resultBackend,
+			store:   deadLetters,
+		}
 	}
 
-	server, err := machinery.NewServer(cnf)
-	if err != nil {
-		// do something with the error
-	}
+	server := machinery.NewServerWithBrokerBackend(backend.Config(), backend.Broker(), resultBackend)
 
 	// queue connector
 	connector := QueueConnector{
-		logger: util.Logger().With("module", "QueueConnector"),
-		Server: server,
+		logger:      util.Logger().With("module", "QueueConnector", "backend", backend.String()),
+		Server:      server,
+		Backend:     backend,
+		DeadLetters: deadLetters,
 	}
 
 	// connector
@@ -60,3 +55,21 @@ func (qc *QueueConnector) StartWorker() {
 	errors := make(chan error)
 	worker.LaunchAsync(errors)
 }
+
+// QueueSize returns number of pending and delayed tasks
+func (qc *QueueConnector) QueueSize() (int, error) {
+	broker := qc.Server.GetBroker()
+
+	pending, err := broker.GetPendingTasks(QueueName)
+	if err != nil {
+		return 0, err
+	}
+
+	// not every broker keeps delayed tasks on its side (eg. amqp uses per-delay queues)
+	delayed, err := broker.GetDelayedTasks()
+	if err != nil {
+		delayed = nil
+	}
+
+	return len(pending) + len(delayed), nil
+}
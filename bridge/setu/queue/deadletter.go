@@ -0,0 +1,168 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+
+	backendsiface "github.com/RichardKnop/machinery/v1/backends/iface"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+const (
+	deadLetterPrefix = "dead-letter-" // dead-lettered tasks by task uuid
+)
+
+// ErrDeadLetterNotFound is returned when dead-lettered task doesn't exist
+var ErrDeadLetterNotFound = errors.New("Dead-lettered task not found")
+
+// DeadLetter is a task which failed after all retries
+type DeadLetter struct {
+	UUID      string           `json:"uuid"`
+	Signature *tasks.Signature `json:"signature"`
+	Error     string           `json:"error"`
+	Attempts  int              `json:"attempts"`
+	FailedAt  time.Time        `json:"failed_at"`
+}
+
+// DeadLetterStore keeps failed tasks in bridge db so they can be inspected and replayed
+type DeadLetterStore struct {
+	db *leveldb.DB
+}
+
+// NewDeadLetterStore creates dead-letter store
+func NewDeadLetterStore(db *leveldb.DB) *DeadLetterStore {
+	return &DeadLetterStore{
+		db: db,
+	}
+}
+
+// Add records failed task
+func (s *DeadLetterStore) Add(signature *tasks.Signature, taskErr string) error {
+	deadLetter := DeadLetter{
+		UUID:      signature.UUID,
+		Signature: signature,
+		Error:     taskErr,
+		// signature.RetryCount is decremented on each retry
+		Attempts: util.TaskRetryCount - signature.RetryCount + 1,
+		FailedAt: time.Now().UTC(),
+	}
+
+	data, err := json.Marshal(deadLetter)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(deadLetterKey(signature.UUID), data, nil)
+}
+
+// Get returns dead-lettered task by uuid
+func (s *DeadLetterStore) Get(uuid string) (*DeadLetter, error) {
+	data, err := s.db.Get(deadLetterKey(uuid), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrDeadLetterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return decodeDeadLetter(data)
+}
+
+// List returns all dead-lettered tasks, oldest first
+func (s *DeadLetterStore) List() ([]*DeadLetter, error) {
+	iter := s.db.NewIterator(leveldbUtil.BytesPrefix([]byte(deadLetterPrefix)), nil)
+	defer iter.Release()
+
+	var result []*DeadLetter
+	for iter.Next() {
+		deadLetter, err := decodeDeadLetter(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, deadLetter)
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].FailedAt.Before(result[j].FailedAt)
+	})
+
+	return result, nil
+}
+
+// Discard removes dead-lettered task
+func (s *DeadLetterStore) Discard(uuid string) error {
+	if has, err := s.db.Has(deadLetterKey(uuid), nil); err != nil {
+		return err
+	} else if !has {
+		return ErrDeadLetterNotFound
+	}
+
+	return s.db.Delete(deadLetterKey(uuid), nil)
+}
+
+// Replay sends dead-lettered task to the queue again with fresh retries and removes it from the store
+func (s *DeadLetterStore) Replay(uuid string, connector *QueueConnector) error {
+	deadLetter, err := s.Get(uuid)
+	if err != nil {
+		return err
+	}
+
+	signature := deadLetter.Signature
+	signature.UUID = ""
+	signature.ETA = nil
+	signature.RetryCount = util.TaskRetryCount
+	signature.RetryTimeout = 0
+
+	if _, err := connector.Server.SendTask(signature); err != nil {
+		return err
+	}
+
+	return s.db.Delete(deadLetterKey(uuid), nil)
+}
+
+func deadLetterKey(uuid string) []byte {
+	return []byte(deadLetterPrefix + uuid)
+}
+
+// decodeDeadLetter decodes dead letter keeping task args as json.Number
+func decodeDeadLetter(data []byte) (*DeadLetter, error) {
+	deadLetter := new(DeadLetter)
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(deadLetter); err != nil {
+		return nil, err
+	}
+
+	return deadLetter, nil
+}
+
+//
+// Result backend
+//
+
+// deadLetterBackend wraps result backend and records failed tasks in dead-letter store
+type deadLetterBackend struct {
+	backendsiface.Backend
+
+	store *DeadLetterStore
+}
+
+// SetStateFailure is called by machinery worker once task has no retries left
+func (b *deadLetterBackend) SetStateFailure(signature *tasks.Signature, taskErr string) error {
+	if err := b.store.Add(signature, taskErr); err != nil {
+		util.Logger().Error("Error while adding task to dead-letter store", "taskName", signature.Name, "uuid", signature.UUID, "error", err)
+	}
+
+	return b.Backend.SetStateFailure(signature, taskErr)
+}
@@ -0,0 +1,56 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+func TestDeadLetterStore(t *testing.T) {
+	viper.Set("log_level", "info")
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	store := NewDeadLetterStore(db)
+	connector := NewQueueConnector(NewLevelDBBackend(db), store)
+
+	signature := &tasks.Signature{
+		UUID: "task_1",
+		Name: "sendCheckpointToRootchain",
+		Args: []tasks.Arg{
+			{Type: "string", Value: "event"},
+			{Type: "int64", Value: int64(10)},
+		},
+	}
+
+	// failure recorded through result backend
+	require.NoError(t, connector.Server.GetBackend().SetStateFailure(signature, "rpc error"))
+
+	deadLetters, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, "rpc error", deadLetters[0].Error)
+	require.Equal(t, 4, deadLetters[0].Attempts)
+	require.Equal(t, "sendCheckpointToRootchain", deadLetters[0].Signature.Name)
+	require.Len(t, deadLetters[0].Signature.Args, 2)
+
+	// replay sends task to the queue and removes dead letter
+	require.NoError(t, store.Replay("task_1", connector))
+	_, err = store.Get("task_1")
+	require.Equal(t, ErrDeadLetterNotFound, err)
+
+	pending, err := connector.Server.GetBroker().GetPendingTasks(QueueName)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, "sendCheckpointToRootchain", pending[0].Name)
+
+	// discard
+	require.NoError(t, store.Add(signature, "rpc error"))
+	require.NoError(t, store.Discard("task_1"))
+	require.Equal(t, ErrDeadLetterNotFound, store.Discard("task_1"))
+}
@@ -0,0 +1,380 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/backends/null"
+	"github.com/RichardKnop/machinery/v1/common"
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+
+	backendsiface "github.com/RichardKnop/machinery/v1/backends/iface"
+	brokersiface "github.com/RichardKnop/machinery/v1/brokers/iface"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	queuedTaskPrefix   = "queue-task-"     // queued tasks, ordered by eta
+	inflightTaskPrefix = "queue-inflight-" // tasks handed to worker but not yet finished
+
+	// DefaultLevelDBPollInterval is interval to check for ready tasks when queue is idle
+	DefaultLevelDBPollInterval = 1 * time.Second
+)
+
+// LevelDBBackend is embedded task queue backend persisted in bridge db
+type LevelDBBackend struct {
+	db     *leveldb.DB
+	cnf    *config.Config
+	broker *LevelDBBroker
+}
+
+// NewLevelDBBackend creates leveldb backend
+func NewLevelDBBackend(db *leveldb.DB) *LevelDBBackend {
+	cnf := &config.Config{
+		Broker:        helper.LevelDBQueueBackend,
+		DefaultQueue:  QueueName,
+		ResultBackend: "null",
+		NoUnixSignals: true,
+	}
+
+	return &LevelDBBackend{
+		db:     db,
+		cnf:    cnf,
+		broker: NewLevelDBBroker(cnf, db, DefaultLevelDBPollInterval),
+	}
+}
+
+// Config returns machinery config
+func (lb *LevelDBBackend) Config() *config.Config {
+	return lb.cnf
+}
+
+// Broker returns leveldb broker
+func (lb *LevelDBBackend) Broker() brokersiface.Broker {
+	return lb.broker
+}
+
+// ResultBackend returns null result backend as bridge tasks don't use results
+func (lb *LevelDBBackend) ResultBackend() backendsiface.Backend {
+	return null.New()
+}
+
+// Purge removes all queued and in-flight tasks
+func (lb *LevelDBBackend) Purge() error {
+	return lb.broker.Purge()
+}
+
+func (lb *LevelDBBackend) String() string {
+	return helper.LevelDBQueueBackend
+}
+
+//
+// LevelDB broker
+//
+
+// LevelDBBroker is machinery broker which stores tasks in leveldb.
+// Tasks are kept under keys sorted by ETA, so immediate and delayed tasks share one queue.
+type LevelDBBroker struct {
+	common.Broker
+
+	logger       log.Logger
+	db           *leveldb.DB
+	pollInterval time.Duration
+
+	popMutex     sync.Mutex
+	consumingWG  sync.WaitGroup
+	processingWG sync.WaitGroup
+}
+
+// NewLevelDBBroker creates new leveldb broker
+func NewLevelDBBroker(cnf *config.Config, db *leveldb.DB, pollInterval time.Duration) *LevelDBBroker {
+	return &LevelDBBroker{
+		Broker:       common.NewBroker(cnf),
+		logger:       util.Logger().With("module", "LevelDBBroker"),
+		db:           db,
+		pollInterval: pollInterval,
+	}
+}
+
+// StartConsuming pops ready tasks and hands them to task processor
+func (b *LevelDBBroker) StartConsuming(consumerTag string, concurrency int, taskProcessor brokersiface.TaskProcessor) (bool, error) {
+	b.consumingWG.Add(1)
+	defer b.consumingWG.Done()
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	b.Broker.StartConsuming(consumerTag, concurrency, taskProcessor)
+
+	// tasks left in-flight by previous run (eg. crash) are queued again
+	if err := b.requeueInflight(); err != nil {
+		return b.GetRetry(), err
+	}
+
+	pool := make(chan struct{}, concurrency)
+	for i := 0; i < concurrency; i++ {
+		pool <- struct{}{}
+	}
+
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.GetStopChan():
+			// wait for running tasks
+			b.processingWG.Wait()
+			return b.GetRetry(), nil
+		case <-pool:
+			signature, err := b.nextTask()
+			if err != nil {
+				b.logger.Error("Error while fetching next task", "error", err)
+			}
+
+			if signature == nil {
+				pool <- struct{}{}
+
+				// wait for next poll
+				select {
+				case <-b.GetStopChan():
+					b.processingWG.Wait()
+					return b.GetRetry(), nil
+				case <-ticker.C:
+				}
+				continue
+			}
+
+			b.processingWG.Add(1)
+			go func() {
+				defer func() {
+					b.processingWG.Done()
+					pool <- struct{}{}
+				}()
+
+				if err := b.consumeOne(signature, taskProcessor); err != nil {
+					b.logger.Error("Error while processing task", "taskName", signature.Name, "uuid", signature.UUID, "error", err)
+				}
+			}()
+		}
+	}
+}
+
+// StopConsuming quits consuming loop
+func (b *LevelDBBroker) StopConsuming() {
+	b.Broker.StopConsuming()
+	b.consumingWG.Wait()
+}
+
+// Publish stores task in the queue
+func (b *LevelDBBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	// Adjust routing key (this decides which queue the message will be published to)
+	b.Broker.AdjustRoutingKey(signature)
+
+	msg, err := json.Marshal(signature)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	eta := time.Now().UTC()
+	if signature.ETA != nil && signature.ETA.After(eta) {
+		eta = *signature.ETA
+	}
+
+	return b.db.Put(queuedTaskKey(eta, signature.UUID), msg, nil)
+}
+
+// GetPendingTasks returns tasks which are ready to be processed
+func (b *LevelDBBroker) GetPendingTasks(queue string) ([]*tasks.Signature, error) {
+	now := time.Now().UTC()
+	return b.getTasks(func(eta time.Time) bool {
+		return !eta.After(now)
+	})
+}
+
+// GetDelayedTasks returns tasks which are scheduled for later
+func (b *LevelDBBroker) GetDelayedTasks() ([]*tasks.Signature, error) {
+	now := time.Now().UTC()
+	return b.getTasks(func(eta time.Time) bool {
+		return eta.After(now)
+	})
+}
+
+// Purge removes all queued and in-flight tasks
+func (b *LevelDBBroker) Purge() error {
+	b.popMutex.Lock()
+	defer b.popMutex.Unlock()
+
+	batch := new(leveldb.Batch)
+	for _, prefix := range []string{queuedTaskPrefix, inflightTaskPrefix} {
+		iter := b.db.NewIterator(leveldbUtil.BytesPrefix([]byte(prefix)), nil)
+		for iter.Next() {
+			batch.Delete(append([]byte{}, iter.Key()...))
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+
+	return b.db.Write(batch, nil)
+}
+
+// consumeOne processes single task and clears its in-flight entry
+func (b *LevelDBBroker) consumeOne(signature *tasks.Signature, taskProcessor brokersiface.TaskProcessor) error {
+	defer b.db.Delete(inflightTaskKey(signature.UUID), nil)
+
+	// If the task is not registered, we requeue it,
+	// there might be different workers for processing specific tasks
+	if !b.IsTaskRegistered(signature.Name) {
+		if signature.IgnoreWhenTaskNotRegistered {
+			return nil
+		}
+
+		b.logger.Info("Task not registered with this worker. Requeuing task", "taskName", signature.Name)
+		eta := time.Now().UTC().Add(b.pollInterval)
+		signature.ETA = &eta
+		return b.Publish(context.Background(), signature)
+	}
+
+	return taskProcessor.Process(signature)
+}
+
+// nextTask moves first ready task to in-flight and returns it
+func (b *LevelDBBroker) nextTask() (*tasks.Signature, error) {
+	b.popMutex.Lock()
+	defer b.popMutex.Unlock()
+
+	iter := b.db.NewIterator(leveldbUtil.BytesPrefix([]byte(queuedTaskPrefix)), nil)
+	defer iter.Release()
+
+	if !iter.First() {
+		return nil, iter.Error()
+	}
+
+	eta, err := parseQueuedTaskKey(iter.Key())
+	if err != nil {
+		return nil, err
+	}
+
+	if eta.After(time.Now().UTC()) {
+		return nil, nil
+	}
+
+	signature, err := decodeSignature(iter.Value())
+	if err != nil {
+		// drop malformed task so that it won't block the queue
+		b.db.Delete(iter.Key(), nil)
+		return nil, err
+	}
+
+	batch := new(leveldb.Batch)
+	batch.Delete(iter.Key())
+	batch.Put(inflightTaskKey(signature.UUID), iter.Value())
+	if err := b.db.Write(batch, nil); err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+// requeueInflight moves all in-flight tasks back to the queue
+func (b *LevelDBBroker) requeueInflight() error {
+	b.popMutex.Lock()
+	defer b.popMutex.Unlock()
+
+	now := time.Now().UTC()
+	batch := new(leveldb.Batch)
+
+	iter := b.db.NewIterator(leveldbUtil.BytesPrefix([]byte(inflightTaskPrefix)), nil)
+	for iter.Next() {
+		uuid := strings.TrimPrefix(string(iter.Key()), inflightTaskPrefix)
+		batch.Delete(append([]byte{}, iter.Key()...))
+		batch.Put(queuedTaskKey(now, uuid), append([]byte{}, iter.Value()...))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	if batch.Len() > 0 {
+		b.logger.Info("Requeuing in-flight tasks", "count", batch.Len()/2)
+	}
+
+	return b.db.Write(batch, nil)
+}
+
+// getTasks returns queued tasks whose eta matches filter
+func (b *LevelDBBroker) getTasks(filter func(time.Time) bool) ([]*tasks.Signature, error) {
+	iter := b.db.NewIterator(leveldbUtil.BytesPrefix([]byte(queuedTaskPrefix)), nil)
+	defer iter.Release()
+
+	var result []*tasks.Signature
+	for iter.Next() {
+		eta, err := parseQueuedTaskKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		if !filter(eta) {
+			continue
+		}
+
+		signature, err := decodeSignature(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, signature)
+	}
+
+	return result, iter.Error()
+}
+
+//
+// Key helpers
+//
+
+// queuedTaskKey returns key for queued task (zero padded eta keeps keys sorted by time)
+func queuedTaskKey(eta time.Time, uuid string) []byte {
+	return []byte(fmt.Sprintf("%s%020d-%s", queuedTaskPrefix, eta.UnixNano(), uuid))
+}
+
+// parseQueuedTaskKey returns eta from queued task key
+func parseQueuedTaskKey(key []byte) (time.Time, error) {
+	parts := strings.SplitN(strings.TrimPrefix(string(key), queuedTaskPrefix), "-", 2)
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid queued task key %s: %v", key, err)
+	}
+
+	return time.Unix(0, nanos).UTC(), nil
+}
+
+// inflightTaskKey returns key for in-flight task
+func inflightTaskKey(uuid string) []byte {
+	return []byte(inflightTaskPrefix + uuid)
+}
+
+// decodeSignature decodes task signature, keeping numbers as json.Number like other machinery brokers
+func decodeSignature(data []byte) (*tasks.Signature, error) {
+	signature := new(tasks.Signature)
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(signature); err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
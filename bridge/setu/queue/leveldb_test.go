@@ -0,0 +1,102 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+type testTaskProcessor struct {
+	processed chan *tasks.Signature
+}
+
+func (p *testTaskProcessor) Process(signature *tasks.Signature) error {
+	p.processed <- signature
+	return nil
+}
+
+func (p *testTaskProcessor) CustomQueue() string {
+	return ""
+}
+
+func newTestBroker(t *testing.T) *LevelDBBroker {
+	viper.Set("log_level", "info")
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	cnf := &config.Config{DefaultQueue: QueueName}
+	return NewLevelDBBroker(cnf, db, 10*time.Millisecond)
+}
+
+func TestLevelDBBrokerQueue(t *testing.T) {
+	broker := newTestBroker(t)
+
+	delayed := time.Now().Add(time.Hour)
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "1", Name: "delayed", ETA: &delayed}))
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "2", Name: "ready"}))
+
+	pending, err := broker.GetPendingTasks(QueueName)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, "ready", pending[0].Name)
+
+	delayedTasks, err := broker.GetDelayedTasks()
+	require.NoError(t, err)
+	require.Len(t, delayedTasks, 1)
+	require.Equal(t, "delayed", delayedTasks[0].Name)
+
+	// pop ready task, delayed one stays queued
+	signature, err := broker.nextTask()
+	require.NoError(t, err)
+	require.Equal(t, "2", signature.UUID)
+
+	signature, err = broker.nextTask()
+	require.NoError(t, err)
+	require.Nil(t, signature)
+
+	// in-flight task is queued again on restart
+	require.NoError(t, broker.requeueInflight())
+	pending, err = broker.GetPendingTasks(QueueName)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	require.NoError(t, broker.Purge())
+	pending, err = broker.GetPendingTasks(QueueName)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+	delayedTasks, err = broker.GetDelayedTasks()
+	require.NoError(t, err)
+	require.Empty(t, delayedTasks)
+}
+
+func TestLevelDBBrokerConsume(t *testing.T) {
+	broker := newTestBroker(t)
+	broker.SetRegisteredTaskNames([]string{"task"})
+
+	processor := &testTaskProcessor{processed: make(chan *tasks.Signature, 1)}
+	go broker.StartConsuming("test", 1, processor)
+
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "1", Name: "task"}))
+
+	select {
+	case signature := <-processor.processed:
+		require.Equal(t, "1", signature.UUID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("task was not processed")
+	}
+
+	broker.StopConsuming()
+
+	// nothing left in the queue or in-flight
+	has, err := broker.db.Has(inflightTaskKey("1"), nil)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,38 @@
+package queue
+
+import (
+	"github.com/RichardKnop/machinery/v1/tasks"
+
+	backendsiface "github.com/RichardKnop/machinery/v1/backends/iface"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+)
+
+// metricsBackend wraps result backend and counts task state changes
+type metricsBackend struct {
+	backendsiface.Backend
+}
+
+// SetStatePending is called whenever task is published (including retries)
+func (b *metricsBackend) SetStatePending(signature *tasks.Signature) error {
+	metrics.TasksSent.WithLabelValues(signature.Name).Inc()
+	return b.Backend.SetStatePending(signature)
+}
+
+// SetStateRetry is called before failed task is published again
+func (b *metricsBackend) SetStateRetry(signature *tasks.Signature) error {
+	metrics.TasksRetried.WithLabelValues(signature.Name).Inc()
+	return b.Backend.SetStateRetry(signature)
+}
+
+// SetStateSuccess is called once task is processed
+func (b *metricsBackend) SetStateSuccess(signature *tasks.Signature, results []*tasks.TaskResult) error {
+	metrics.TasksProcessed.WithLabelValues(signature.Name).Inc()
+	return b.Backend.SetStateSuccess(signature, results)
+}
+
+// SetStateFailure is called once task has no retries left
+func (b *metricsBackend) SetStateFailure(signature *tasks.Signature, taskErr string) error {
+	metrics.TasksFailed.WithLabelValues(signature.Name).Inc()
+	return b.Backend.SetStateFailure(signature, taskErr)
+}
@@ -0,0 +1,181 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// DefaultTrackedBlocks is number of recent processed blocks kept for reorg detection
+	DefaultTrackedBlocks = 1024
+
+	rootChainTrackerName = "rootchain"
+)
+
+// TrackedBlock is a processed block number and its hash
+type TrackedBlock struct {
+	Number uint64
+	Hash   common.Hash
+}
+
+// BlockTracker keeps hashes of processed blocks in bridge db to detect reorgs.
+// Blocks orphaned by a reorg are marked stale, so tasks created from their logs can be skipped.
+type BlockTracker struct {
+	db            *leveldb.DB
+	hashPrefix    string
+	stalePrefix   string
+	trackedBlocks uint64
+}
+
+// NewBlockTracker creates block tracker for given chain name (eg. rootchain)
+func NewBlockTracker(db *leveldb.DB, chain string) *BlockTracker {
+	return &BlockTracker{
+		db:            db,
+		hashPrefix:    chain + "-block-hash-",
+		stalePrefix:   chain + "-stale-block-",
+		trackedBlocks: DefaultTrackedBlocks,
+	}
+}
+
+// NewRootChainBlockTracker creates block tracker for rootchain blocks
+func NewRootChainBlockTracker(db *leveldb.DB) *BlockTracker {
+	return NewBlockTracker(db, rootChainTrackerName)
+}
+
+// Track stores processed block hash
+func (bt *BlockTracker) Track(number uint64, hash common.Hash) error {
+	batch := new(leveldb.Batch)
+	batch.Put(bt.hashKey(number), hash.Bytes())
+	// block may become canonical again after being orphaned
+	batch.Delete(bt.staleKey(hash))
+	return bt.db.Write(batch, nil)
+}
+
+// Prune removes tracked and stale blocks older than tracked window from latest block
+func (bt *BlockTracker) Prune(latest uint64) error {
+	if latest <= bt.trackedBlocks {
+		return nil
+	}
+
+	minNumber := latest - bt.trackedBlocks
+	batch := new(leveldb.Batch)
+	if err := bt.prune(batch, bt.hashPrefix, minNumber); err != nil {
+		return err
+	}
+	if err := bt.prune(batch, bt.stalePrefix, minNumber); err != nil {
+		return err
+	}
+
+	return bt.db.Write(batch, nil)
+}
+
+// Latest returns most recent tracked block
+func (bt *BlockTracker) Latest() (*TrackedBlock, error) {
+	iter := bt.db.NewIterator(leveldbUtil.BytesPrefix([]byte(bt.hashPrefix)), nil)
+	defer iter.Release()
+
+	if !iter.Last() {
+		return nil, iter.Error()
+	}
+
+	return bt.parseTrackedBlock(iter.Key(), iter.Value())
+}
+
+// Tracked returns tracked blocks, newest first
+func (bt *BlockTracker) Tracked() ([]*TrackedBlock, error) {
+	iter := bt.db.NewIterator(leveldbUtil.BytesPrefix([]byte(bt.hashPrefix)), nil)
+	defer iter.Release()
+
+	var result []*TrackedBlock
+	for ok := iter.Last(); ok; ok = iter.Prev() {
+		block, err := bt.parseTrackedBlock(iter.Key(), iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, block)
+	}
+
+	return result, iter.Error()
+}
+
+// Rewind drops tracked blocks above ancestor and marks them stale
+func (bt *BlockTracker) Rewind(ancestor uint64) ([]*TrackedBlock, error) {
+	tracked, err := bt.Tracked()
+	if err != nil {
+		return nil, err
+	}
+
+	var orphaned []*TrackedBlock
+	batch := new(leveldb.Batch)
+	for _, block := range tracked {
+		if block.Number <= ancestor {
+			break
+		}
+
+		batch.Delete(bt.hashKey(block.Number))
+		batch.Put(bt.staleKey(block.Hash), []byte(strconv.FormatUint(block.Number, 10)))
+		orphaned = append(orphaned, block)
+	}
+
+	return orphaned, bt.db.Write(batch, nil)
+}
+
+// IsStale checks if block was orphaned by a reorg
+func (bt *BlockTracker) IsStale(hash common.Hash) bool {
+	has, err := bt.db.Has(bt.staleKey(hash), nil)
+	return err == nil && has
+}
+
+// prune removes entries with block number lower than minNumber
+func (bt *BlockTracker) prune(batch *leveldb.Batch, prefix string, minNumber uint64) error {
+	iter := bt.db.NewIterator(leveldbUtil.BytesPrefix([]byte(prefix)), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		number, err := bt.blockNumber(prefix, iter.Key(), iter.Value())
+		if err != nil {
+			return err
+		}
+
+		if number < minNumber {
+			batch.Delete(append([]byte{}, iter.Key()...))
+		}
+	}
+
+	return iter.Error()
+}
+
+// blockNumber returns block number for hash entry (from key) or stale entry (from value)
+func (bt *BlockTracker) blockNumber(prefix string, key []byte, value []byte) (uint64, error) {
+	if prefix == bt.stalePrefix {
+		return strconv.ParseUint(string(value), 10, 64)
+	}
+
+	return strconv.ParseUint(strings.TrimPrefix(string(key), prefix), 10, 64)
+}
+
+func (bt *BlockTracker) parseTrackedBlock(key []byte, value []byte) (*TrackedBlock, error) {
+	number, err := bt.blockNumber(bt.hashPrefix, key, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrackedBlock{
+		Number: number,
+		Hash:   common.BytesToHash(value),
+	}, nil
+}
+
+// hashKey returns key for tracked block (zero padded number keeps keys sorted)
+func (bt *BlockTracker) hashKey(number uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", bt.hashPrefix, number))
+}
+
+func (bt *BlockTracker) staleKey(hash common.Hash) []byte {
+	return []byte(bt.stalePrefix + hash.Hex())
+}
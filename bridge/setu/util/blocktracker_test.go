@@ -0,0 +1,60 @@
+package util
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+func TestBlockTracker(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	tracker := NewRootChainBlockTracker(db)
+	tracker.trackedBlocks = 3
+
+	hashOf := func(number uint64) common.Hash {
+		return common.BigToHash(big.NewInt(int64(number)))
+	}
+
+	latest, err := tracker.Latest()
+	require.NoError(t, err)
+	require.Nil(t, latest)
+
+	for number := uint64(8); number <= 12; number++ {
+		require.NoError(t, tracker.Track(number, hashOf(number)))
+	}
+
+	latest, err = tracker.Latest()
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), latest.Number)
+	require.Equal(t, hashOf(12), latest.Hash)
+
+	// rewind marks orphaned blocks stale
+	orphaned, err := tracker.Rewind(10)
+	require.NoError(t, err)
+	require.Len(t, orphaned, 2)
+	require.True(t, tracker.IsStale(hashOf(11)))
+	require.True(t, tracker.IsStale(hashOf(12)))
+	require.False(t, tracker.IsStale(hashOf(10)))
+
+	latest, err = tracker.Latest()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), latest.Number)
+
+	// block becoming canonical again is not stale
+	require.NoError(t, tracker.Track(11, hashOf(11)))
+	require.False(t, tracker.IsStale(hashOf(11)))
+
+	// prune keeps tracked window only
+	require.NoError(t, tracker.Prune(12))
+	tracked, err := tracker.Tracked()
+	require.NoError(t, err)
+	require.Len(t, tracked, 3)
+	require.Equal(t, uint64(11), tracked[0].Number)
+	require.Equal(t, uint64(9), tracked[2].Number)
+}
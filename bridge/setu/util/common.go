@@ -48,14 +48,19 @@ const (
 	DividendAccountRootURL = "/staking/dividend-account-root"
 	ValidatorURL           = "/staking/validator/%v"
 	CurrentValidatorSetURL = "staking/validator-set"
+	AllValidatorsURL       = "/staking/validators"
 	StakingTxStatusURL     = "/staking/isoldtx"
 	TopupTxStatusURL       = "/topup/isoldtx"
 	ClerkTxStatusURL       = "/clerk/isoldtx"
+	PendingSideTxsURL      = "/sidetx/pending"
+	VerifySideTxURL        = "/sidetx/side-tx/%v/verify"
+	PendingSignerUpdateURL = "/staking/validator/%v/pending-signer-update"
 
 	TransactionTimeout      = 1 * time.Minute
 	CommitTimeout           = 2 * time.Minute
 	TaskDelayBetweenEachVal = 6 * time.Second
 	RetryTaskDelay          = 12 * time.Second
+	TaskRetryCount          = 3 // machinery retries before task is dead-lettered
 
 	BridgeDBFlag = "bridge-db"
 )
@@ -102,6 +107,16 @@ func IsProposer(cliCtx cliContext.CLIContext) (bool, error) {
 
 // IsInProposerList checks if we are in current proposer
 func IsInProposerList(cliCtx cliContext.CLIContext, count uint64) (bool, error) {
+	position, err := GetProposerPosition(cliCtx, count)
+	if err != nil {
+		return false, err
+	}
+
+	return position > 0, nil
+}
+
+// GetProposerPosition returns our 1-based position in next `count` proposers, 0 if we are not in the list
+func GetProposerPosition(cliCtx cliContext.CLIContext, count uint64) (int, error) {
 	logger.Debug("Skipping proposers", "count", strconv.FormatUint(count, 10))
 	response, err := helper.FetchFromAPI(
 		cliCtx,
@@ -109,23 +124,23 @@ func IsInProposerList(cliCtx cliContext.CLIContext, count uint64) (bool, error)
 	)
 	if err != nil {
 		logger.Error("Unable to send request for next proposers", "url", ProposersURL, "error", err)
-		return false, err
+		return 0, err
 	}
 
 	// unmarshall data from buffer
 	var proposers []hmtypes.Validator
 	if err := json.Unmarshal(response.Result, &proposers); err != nil {
 		logger.Error("Error unmarshalling validator data ", "error", err)
-		return false, err
+		return 0, err
 	}
 
 	logger.Debug("Fetched proposers list", "numberOfProposers", count)
-	for _, proposer := range proposers {
+	for i, proposer := range proposers {
 		if bytes.Equal(proposer.Signer.Bytes(), helper.GetAddress()) {
-			return true, nil
+			return i + 1, nil
 		}
 	}
-	return false, nil
+	return 0, nil
 }
 
 // CalculateTaskDelay calculates delay required for current validator to propose the tx
@@ -0,0 +1,62 @@
+package util
+
+import (
+	"github.com/spf13/viper"
+)
+
+const (
+	// AllServicesFlag starts all listeners and processors
+	AllServicesFlag = "all"
+	// OnlyServicesFlag starts only given listeners and processors
+	OnlyServicesFlag = "only"
+
+	// ListenersServiceGroup selects all listeners with --only (listener-only mode)
+	ListenersServiceGroup = "listeners"
+	// ProcessorsServiceGroup selects all processors with --only (processor-only mode)
+	ProcessorsServiceGroup = "processors"
+)
+
+// IsServiceSelected checks if listener or processor is selected with --all or --only flags.
+// Group name selects all services of the group.
+func IsServiceSelected(name string, group string) bool {
+	if viper.GetBool(AllServicesFlag) {
+		return true
+	}
+
+	for _, service := range viper.GetStringSlice(OnlyServicesFlag) {
+		if service == name || service == group {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsListenerSelected checks if listener is selected with --all or --only flags.
+// Processors selected by name also select listeners feeding them tasks, as --only used to select processors only.
+func IsListenerSelected(name string, processors []string) bool {
+	if IsServiceSelected(name, ListenersServiceGroup) {
+		return true
+	}
+
+	for _, service := range viper.GetStringSlice(OnlyServicesFlag) {
+		for _, processor := range processors {
+			if service == processor {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// IsAnyServiceSelected checks if any service of the group is selected
+func IsAnyServiceSelected(names []string, group string) bool {
+	for _, name := range names {
+		if IsServiceSelected(name, group) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,38 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsServiceSelected(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set(AllServicesFlag, true)
+	require.True(t, IsServiceSelected("rootchain", ListenersServiceGroup))
+	require.True(t, IsServiceSelected("checkpoint", ProcessorsServiceGroup))
+
+	// single listener and processor
+	viper.Set(AllServicesFlag, false)
+	viper.Set(OnlyServicesFlag, []string{"rootchain", "clerk"})
+	require.True(t, IsServiceSelected("rootchain", ListenersServiceGroup))
+	require.False(t, IsServiceSelected("heimdall", ListenersServiceGroup))
+	require.True(t, IsServiceSelected("clerk", ProcessorsServiceGroup))
+	require.False(t, IsServiceSelected("checkpoint", ProcessorsServiceGroup))
+
+	// processor selects listeners feeding it
+	viper.Set(OnlyServicesFlag, []string{"checkpoint"})
+	require.True(t, IsListenerSelected("rootchain", []string{"checkpoint", "staking"}))
+	require.False(t, IsListenerSelected("rootchain", []string{"staking"}))
+
+	// processor-only mode
+	viper.Set(OnlyServicesFlag, []string{ProcessorsServiceGroup})
+	require.False(t, IsListenerSelected("rootchain", []string{"checkpoint", "staking"}))
+
+	// listener-only mode
+	viper.Set(OnlyServicesFlag, []string{ListenersServiceGroup})
+	require.True(t, IsAnyServiceSelected([]string{"rootchain", "heimdall"}, ListenersServiceGroup))
+	require.False(t, IsAnyServiceSelected([]string{"checkpoint", "clerk"}, ProcessorsServiceGroup))
+}
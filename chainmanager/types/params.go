@@ -41,6 +41,46 @@ type ChainParams struct {
 	// Bor Chain Contracts
 	StateReceiverAddress hmTypes.HeimdallAddress `json:"state_receiver_address" yaml:"state_receiver_address"`
 	ValidatorSetAddress  hmTypes.HeimdallAddress `json:"validator_set_address" yaml:"validator_set_address"`
+
+	// Additional checkpoint targets, besides bor chain checkpointed to root chain contract above
+	CheckpointChains []CheckpointChain `json:"checkpoint_chains,omitempty" yaml:"checkpoint_chains"`
+}
+
+// CheckpointChain represents child chain checkpointed to rootchain contract on settlement chain
+type CheckpointChain struct {
+	ChainID          string                  `json:"chain_id" yaml:"chain_id"`         // identifier used in checkpoint messages
+	BorChainID       string                  `json:"bor_chain_id" yaml:"bor_chain_id"` // child chain which is checkpointed
+	RootChainAddress hmTypes.HeimdallAddress `json:"root_chain_address" yaml:"root_chain_address"`
+}
+
+// DefaultCheckpointChain returns checkpoint target of bor chain and root chain contract,
+// identified by bor chain id
+func (cp ChainParams) DefaultCheckpointChain() CheckpointChain {
+	return CheckpointChain{
+		ChainID:          cp.BorChainID,
+		BorChainID:       cp.BorChainID,
+		RootChainAddress: cp.RootChainAddress,
+	}
+}
+
+// GetCheckpointChain returns checkpoint target by chain id, empty chain id is default target
+func (cp ChainParams) GetCheckpointChain(chainID string) (CheckpointChain, bool) {
+	if chainID == "" || chainID == cp.BorChainID {
+		return cp.DefaultCheckpointChain(), true
+	}
+
+	for _, chain := range cp.CheckpointChains {
+		if chain.ChainID == chainID {
+			return chain, true
+		}
+	}
+
+	return CheckpointChain{}, false
+}
+
+// GetAllCheckpointChains returns all checkpoint targets, default target first
+func (cp ChainParams) GetAllCheckpointChains() []CheckpointChain {
+	return append([]CheckpointChain{cp.DefaultCheckpointChain()}, cp.CheckpointChains...)
 }
 
 func (cp ChainParams) String() string {
@@ -126,6 +166,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	chainIDs := map[string]bool{p.ChainParams.BorChainID: true}
+	for _, chain := range p.ChainParams.CheckpointChains {
+		if chain.ChainID == "" || chain.BorChainID == "" {
+			return fmt.Errorf("Invalid checkpoint chain %v in chain_params", chain.ChainID)
+		}
+
+		if chainIDs[chain.ChainID] {
+			return fmt.Errorf("Duplicate checkpoint chain %v in chain_params", chain.ChainID)
+		}
+		chainIDs[chain.ChainID] = true
+
+		if err := validateHeimdallAddress("checkpoint_chains.root_chain_address", chain.RootChainAddress); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
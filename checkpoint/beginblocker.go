@@ -0,0 +1,46 @@
+package checkpoint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// BeginBlocker records signers of the block which included buffered checkpoint of each checkpoint chain.
+// These precommits are submitted to rootchain along with the checkpoint.
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	for _, chain := range k.ck.GetParams(ctx).ChainParams.GetAllCheckpointChains() {
+		if ck, _, err := k.ForChain(ctx, chain.ChainID); err == nil {
+			recordCheckpointSignatures(ctx, req, ck)
+		}
+	}
+}
+
+// recordCheckpointSignatures records signers of buffered checkpoint if it was included in last block
+func recordCheckpointSignatures(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	height, found := k.GetBufferedCheckpointHeight(ctx)
+	if !found || height != ctx.BlockHeight()-1 {
+		return
+	}
+
+	signers := make([]types.CheckpointSigner, 0, len(req.LastCommitInfo.Votes))
+	for _, vote := range req.LastCommitInfo.Votes {
+		signer := types.CheckpointSigner{
+			Signer:      hmTypes.BytesToHeimdallAddress(vote.Validator.Address),
+			VotingPower: vote.Validator.Power,
+			Signed:      vote.SignedLastBlock,
+		}
+
+		if validator, err := k.sk.GetValidatorInfo(ctx, vote.Validator.Address); err == nil {
+			signer.ValidatorID = validator.ID
+		}
+
+		signers = append(signers, signer)
+	}
+
+	sigs := types.NewCheckpointSignatures(height, signers)
+	k.SetBufferedCheckpointSignatures(ctx, sigs)
+	k.Logger(ctx).Debug("Recorded buffered checkpoint signatures", "height", height, "signedPower", sigs.SignedPower, "totalPower", sigs.TotalPower)
+}
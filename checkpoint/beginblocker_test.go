@@ -0,0 +1,142 @@
+package checkpoint_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/staking"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// commitVotes returns last commit votes of validator set, with first unsigned validators
+func commitVotes(valSet types.ValidatorSet, unsigned int) []abci.VoteInfo {
+	votes := make([]abci.VoteInfo, 0, len(valSet.Validators))
+	for i, val := range valSet.Validators {
+		votes = append(votes, abci.VoteInfo{
+			Validator:       abci.Validator{Address: val.Signer.Bytes(), Power: val.VotingPower},
+			SignedLastBlock: i >= unsigned,
+		})
+	}
+	return votes
+}
+
+// ackBufferedCheckpoint acknowledges buffered checkpoint and returns its header index
+func ackBufferedCheckpoint(t *testing.T, ctx sdk.Context, sk staking.Keeper, ck checkpoint.Keeper) uint64 {
+	buffered, err := ck.GetCheckpointFromBuffer(ctx)
+	require.NoError(t, err)
+
+	headerBlock := ck.GetParams(ctx).ChildBlockInterval * (ck.GetACKCount(ctx) + 1)
+	contractCallerObj := mocks.IContractCaller{}
+	contractCallerObj.On("GetRootChainInstance", mock.Anything).Return(&rootchain.Rootchain{}, nil)
+	contractCallerObj.On("GetHeaderInfo", headerBlock, mock.Anything).Return(ethcmn.Hash(buffered.RootHash), buffered.StartBlock, buffered.EndBlock, buffered.TimeStamp, buffered.Proposer, nil)
+
+	msgAck := checkpointTypes.NewMsgCheckpointAck(buffered.Proposer, headerBlock, types.HeimdallHash{}, 0, "")
+	got := checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msgAck)
+	require.True(t, got.IsOK(), "expected send-ack to be ok, got %v", got)
+	return headerBlock
+}
+
+func TestBeginBlockerRecordsCheckpointSignatures(t *testing.T) {
+	ctx, sk, ck := CreateTestInput(t, false)
+	LoadValidatorSet(4, t, sk, ctx, false, 10)
+	sk.IncrementAccum(ctx, 1)
+	valSet := sk.GetValidatorSet(ctx)
+
+	// checkpoint is buffered at height 10
+	bufferedHeight := int64(10)
+	ctx = ctx.WithBlockHeight(bufferedHeight)
+	header := types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HeimdallHash{}, valSet.Proposer.Signer, uint64(ctx.BlockTime().Unix()))
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, header))
+	ck.SetBufferedCheckpointHeight(ctx, bufferedHeight)
+
+	// votes for buffered height are in last commit of next block, one validator didn't sign
+	checkpoint.BeginBlocker(ctx.WithBlockHeight(bufferedHeight+1), abci.RequestBeginBlock{
+		LastCommitInfo: abci.LastCommitInfo{Votes: commitVotes(valSet, 1)},
+	}, ck)
+
+	// votes of other blocks don't overwrite recorded signers
+	for _, height := range []int64{bufferedHeight, bufferedHeight + 2} {
+		checkpoint.BeginBlocker(ctx.WithBlockHeight(height), abci.RequestBeginBlock{
+			LastCommitInfo: abci.LastCommitInfo{Votes: commitVotes(valSet, 3)},
+		}, ck)
+	}
+
+	headerBlock := ackBufferedCheckpoint(t, ctx, sk, ck)
+
+	sigs, err := ck.GetCheckpointSignatures(ctx, headerBlock)
+	require.NoError(t, err)
+	require.Equal(t, headerBlock, sigs.HeaderIndex)
+	require.Equal(t, bufferedHeight, sigs.Height)
+	require.Len(t, sigs.Signers, len(valSet.Validators))
+
+	for i, signer := range sigs.Signers {
+		val := valSet.Validators[i]
+		require.Equal(t, val.ID, signer.ValidatorID)
+		require.Equal(t, val.Signer, signer.Signer)
+		require.Equal(t, val.VotingPower, signer.VotingPower)
+		require.Equal(t, i >= 1, signer.Signed)
+	}
+
+	totalPower := valSet.TotalVotingPower()
+	require.Equal(t, totalPower, sigs.TotalPower)
+	require.Equal(t, totalPower-valSet.Validators[0].VotingPower, sigs.SignedPower)
+	require.True(t, sigs.ThresholdMet)
+}
+
+func TestBeginBlockerSkipsOtherHeights(t *testing.T) {
+	ctx, sk, ck := CreateTestInput(t, false)
+	LoadValidatorSet(4, t, sk, ctx, false, 10)
+	sk.IncrementAccum(ctx, 1)
+	valSet := sk.GetValidatorSet(ctx)
+
+	// checkpoint is buffered, but last commit of next block is never seen
+	ctx = ctx.WithBlockHeight(10)
+	header := types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HeimdallHash{}, valSet.Proposer.Signer, uint64(ctx.BlockTime().Unix()))
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, header))
+	ck.SetBufferedCheckpointHeight(ctx, 10)
+
+	checkpoint.BeginBlocker(ctx.WithBlockHeight(12), abci.RequestBeginBlock{
+		LastCommitInfo: abci.LastCommitInfo{Votes: commitVotes(valSet, 0)},
+	}, ck)
+
+	headerBlock := ackBufferedCheckpoint(t, ctx, sk, ck)
+
+	_, err := ck.GetCheckpointSignatures(ctx, headerBlock)
+	require.Error(t, err)
+}
+
+func TestAckRecordsValidatorSetAtBufferedHeight(t *testing.T) {
+	ctx, sk, ck := CreateTestInput(t, false)
+	LoadValidatorSet(4, t, sk, ctx, false, 10)
+	sk.IncrementAccum(ctx, 1)
+	valSet := sk.GetValidatorSet(ctx)
+
+	// checkpoint is proposed and signed by validator set at height 10
+	ctx = ctx.WithBlockHeight(10)
+	header := types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HeimdallHash{}, valSet.Proposer.Signer, uint64(ctx.BlockTime().Unix()))
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, header))
+	ck.SetBufferedCheckpointHeight(ctx, 10)
+
+	// validator set changes before ack
+	joined := GenRandomVal(1, 0, 10, 10, false, 5)[0]
+	require.NoError(t, sk.AddValidator(ctx, joined))
+	changed := valSet.Copy()
+	require.NoError(t, changed.UpdateWithChangeSet([]*types.Validator{&joined}))
+	require.NoError(t, sk.UpdateValidatorSetInStore(ctx.WithBlockHeight(15), *changed))
+
+	headerBlock := ackBufferedCheckpoint(t, ctx.WithBlockHeight(20), sk, ck)
+
+	snapshot, ok := sk.GetValidatorSetSnapshotByCheckpoint(ctx, ck.GetChainID(ctx), headerBlock)
+	require.True(t, ok)
+	require.Len(t, snapshot.Validators, len(valSet.Validators))
+	require.True(t, snapshot.Height < 10)
+}
@@ -10,4 +10,9 @@ const (
 	FlagCheckpointTxHash   = "txhash"
 	FlagCheckpointLogIndex = "log-index"
 	FlagAutoConfigure      = "auto-configure"
+	FlagBlockNumber        = "block"
+	FlagPage               = "page"
+	FlagLimit              = "limit"
+	FlagValidatorID        = "id"
+	FlagCheckpointChain    = "checkpoint-chain"
 )
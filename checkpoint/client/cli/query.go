@@ -15,6 +15,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/version"
 )
 
@@ -29,6 +30,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		RunE:                       hmClient.ValidateCmd,
 	}
 
+	supplyQueryCmd.PersistentFlags().String(FlagCheckpointChain, "", "--checkpoint-chain=<checkpoint chain id, default chain if empty>")
+
 	// supply query command
 	supplyQueryCmd.AddCommand(
 		client.GetCommands(
@@ -37,6 +40,12 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetLastNoACK(cdc),
 			GetHeaderFromIndex(cdc),
 			GetCheckpointCount(cdc),
+			GetBlockProof(cdc),
+			GetCheckpointByBlock(cdc),
+			GetCheckpointsByRange(cdc),
+			GetCheckpointsByProposer(cdc),
+			GetValidatorStats(cdc),
+			GetCheckpointSignatures(cdc),
 		)...,
 	)
 
@@ -61,7 +70,7 @@ $ %s query checkpoint params
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+			route := queryPath(types.QueryParams)
 			bz, _, err := cliCtx.QueryWithData(route, nil)
 			if err != nil {
 				return err
@@ -82,7 +91,7 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), nil)
+			res, _, err := cliCtx.QueryWithData(queryPath(types.QueryCheckpointBuffer), nil)
 			if err != nil {
 				return err
 			}
@@ -107,7 +116,7 @@ func GetLastNoACK(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastNoAck), nil)
+			res, _, err := cliCtx.QueryWithData(queryPath(types.QueryLastNoAck), nil)
 			if err != nil {
 				return err
 			}
@@ -145,7 +154,7 @@ func GetHeaderFromIndex(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// fetch checkpoint
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint), queryParams)
+			res, _, err := cliCtx.QueryWithData(queryPath(types.QueryCheckpoint), queryParams)
 			if err != nil {
 				return err
 			}
@@ -167,7 +176,7 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+			res, _, err := cliCtx.QueryWithData(queryPath(types.QueryAckCount), nil)
 			if err != nil {
 				return err
 			}
@@ -188,3 +197,274 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetBlockProof get inclusion proof of bor block in checkpoint
+func GetBlockProof(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof",
+		Short: "get checkpoint inclusion proof of bor block",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get merkle proof of bor block header in checkpoint which covers it:
+
+$ %s query checkpoint proof --block 1000
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			blockNumber := viper.GetUint64(FlagBlockNumber)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockParams(blockNumber))
+			if err != nil {
+				return err
+			}
+
+			// fetch proof
+			res, _, err := cliCtx.QueryWithData(queryPath(types.QueryBlockProof), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block=<bor block number>")
+	cmd.MarkFlagRequired(FlagBlockNumber)
+
+	return cmd
+}
+
+// GetCheckpointByBlock get checkpoint which covers bor block
+func GetCheckpointByBlock(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-by-block",
+		Short: "get checkpoint which covers bor block",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get checkpoint (with header index) which covers bor block:
+
+$ %s query checkpoint checkpoint-by-block --block 1000
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			blockNumber := viper.GetUint64(FlagBlockNumber)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockParams(blockNumber))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoint
+			res, _, err := cliCtx.QueryWithData(queryPath(types.QueryCheckpointByBlock), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block=<bor block number>")
+	cmd.MarkFlagRequired(FlagBlockNumber)
+
+	return cmd
+}
+
+// GetCheckpointsByRange get checkpoints which cover bor blocks from start to end
+func GetCheckpointsByRange(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoints-by-range",
+		Short: "get checkpoints which cover bor block range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get checkpoints (with header indexes) which cover any bor block from start to end:
+
+$ %s query checkpoint checkpoints-by-range --start-block 0 --end-block 10000 --page 1 --limit 10
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockRangeParams(
+				viper.GetUint64(FlagStartBlock),
+				viper.GetUint64(FlagEndBlock),
+				viper.GetUint64(FlagPage),
+				viper.GetUint64(FlagLimit),
+			))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoints
+			res, _, err := cliCtx.QueryWithData(queryPath(types.QueryCheckpointsByRange), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagStartBlock, 0, "--start-block=<start bor block>")
+	cmd.Flags().Uint64(FlagEndBlock, 0, "--end-block=<end bor block>")
+	cmd.Flags().Uint64(FlagPage, 1, "--page=<page number>")
+	cmd.Flags().Uint64(FlagLimit, 10, "--limit=<max checkpoints per page>")
+	cmd.MarkFlagRequired(FlagStartBlock)
+	cmd.MarkFlagRequired(FlagEndBlock)
+
+	return cmd
+}
+
+// GetCheckpointsByProposer get checkpoints proposed by signer
+func GetCheckpointsByProposer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoints-by-proposer",
+		Short: "get checkpoints proposed by signer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get checkpoints (with header indexes) proposed by signer:
+
+$ %s query checkpoint checkpoints-by-proposer --proposer 0x... --page 1 --limit 10
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				return errors.New("Invalid proposer address")
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerParams(
+				proposer,
+				viper.GetUint64(FlagPage),
+				viper.GetUint64(FlagLimit),
+			))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoints
+			res, _, err := cliCtx.QueryWithData(queryPath(types.QueryCheckpointsByProposer), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagProposerAddress, "", "--proposer=<proposer signer address>")
+	cmd.Flags().Uint64(FlagPage, 1, "--page=<page number>")
+	cmd.Flags().Uint64(FlagLimit, 10, "--limit=<max checkpoints per page>")
+	cmd.MarkFlagRequired(FlagProposerAddress)
+
+	return cmd
+}
+
+// GetValidatorStats implements the validator checkpoint stats query command.
+func GetValidatorStats(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-stats",
+		Short: "get checkpoint stats of validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get proposed, acked, no-ack and expired checkpoint counters of a validator, or of all validators if no id is given:
+
+$ %s query checkpoint validator-stats --id 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+
+			var res []byte
+			var err error
+			if validatorID == 0 {
+				res, _, err = cliCtx.QueryWithData(queryPath(types.QueryAllValidatorStats), nil)
+			} else {
+				// get query params
+				var queryParams []byte
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryValidatorStatsParams(hmTypes.NewValidatorID(validatorID)))
+				if err != nil {
+					return err
+				}
+
+				res, _, err = cliCtx.QueryWithData(queryPath(types.QueryValidatorStats), queryParams)
+			}
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here>")
+
+	return cmd
+}
+
+// GetCheckpointSignatures implements the checkpoint signatures query command.
+func GetCheckpointSignatures(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signatures",
+		Short: "get validators who signed checkpoint",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get signers of checkpoint with their voting power and total signed power:
+
+$ %s query checkpoint signatures --header 10000
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(viper.GetUint64(FlagHeaderNumber)))
+			if err != nil {
+				return err
+			}
+
+			// fetch signatures
+			res, _, err := cliCtx.QueryWithData(queryPath(types.QueryCheckpointSignatures), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeaderNumber, 0, "--header=<checkpoint header index>")
+	cmd.MarkFlagRequired(FlagHeaderNumber)
+
+	return cmd
+}
+
+// queryPath returns path of checkpoint query, for checkpoint chain given by flag
+func queryPath(query string) string {
+	if chainID := viper.GetString(FlagCheckpointChain); chainID != "" {
+		return fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, query, chainID)
+	}
+	return fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query)
+}
@@ -63,7 +63,7 @@ func SendCheckpointTx(cdc *codec.Codec) *cobra.Command {
 				}
 
 				// fetch msg checkpoint
-				result, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextCheckpoint))
+				result, _, err := cliCtx.Query(queryPath(types.QueryNextCheckpoint))
 				if err != nil {
 					return err
 				}
@@ -127,6 +127,7 @@ func SendCheckpointTx(cdc *codec.Codec) *cobra.Command {
 				endBlock,
 				hmTypes.HexToHeimdallHash(rootHashStr),
 				hmTypes.HexToHeimdallHash(accountRootHashStr),
+				viper.GetString(FlagCheckpointChain),
 			)
 
 			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
@@ -138,6 +139,7 @@ func SendCheckpointTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringP(FlagRootHash, "r", "", "--root-hash=<root-hash>")
 	cmd.Flags().String(FlagAccountRootHash, "", "--account-root=<account-root>")
 	cmd.Flags().Bool(FlagAutoConfigure, false, "--auto-configure=true/false")
+	cmd.Flags().String(FlagCheckpointChain, "", "--checkpoint-chain=<checkpoint chain id, default chain if empty>")
 
 	return cmd
 }
@@ -174,7 +176,7 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 			checkpointTxHash := hmTypes.BytesToHeimdallHash(common.FromHex(checkpointTxHashStr))
 
 			// new checkpoint
-			msg := types.NewMsgCheckpointAck(proposer, headerBlock, checkpointTxHash, uint64(viper.GetInt64(FlagCheckpointLogIndex)))
+			msg := types.NewMsgCheckpointAck(proposer, headerBlock, checkpointTxHash, uint64(viper.GetInt64(FlagCheckpointLogIndex)), viper.GetString(FlagCheckpointChain))
 
 			// msg
 			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
@@ -185,6 +187,7 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagHeaderNumber, "", "--header=<header-index>")
 	cmd.Flags().StringP(FlagCheckpointTxHash, "t", "", "--txhash=<checkpoint-txhash>")
 	cmd.Flags().String(FlagCheckpointLogIndex, "", "--log-index=<log-index>")
+	cmd.Flags().String(FlagCheckpointChain, "", "--checkpoint-chain=<checkpoint chain id, default chain if empty>")
 
 	cmd.MarkFlagRequired(FlagHeaderNumber)
 	cmd.MarkFlagRequired(FlagCheckpointTxHash)
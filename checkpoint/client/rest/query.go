@@ -15,7 +15,6 @@ import (
 	"github.com/maticnetwork/bor/common"
 	ethcmn "github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
-	"github.com/maticnetwork/heimdall/helper"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmRest "github.com/maticnetwork/heimdall/types/rest"
@@ -38,6 +37,34 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		checkpointHeaderHandlerFn(cliCtx),
 	).Methods("GET")
 
+	r.HandleFunc("/checkpoint/block/{block}",
+		checkpointByBlockHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/range",
+		checkpointsByRangeHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/proposer/{proposer}",
+		checkpointsByProposerHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/validator-stats",
+		allValidatorStatsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/validator-stats/{id}",
+		validatorStatsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/signatures/{headerBlockIndex}",
+		checkpointSignaturesHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/proof/{block}",
+		blockProofHandlerFn(cliCtx),
+	).Methods("GET")
+
 	r.HandleFunc("/checkpoint/latest-checkpoint",
 		latestCheckpointHandlerFunc(cliCtx),
 	).Methods("GET")
@@ -68,7 +95,7 @@ func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+		route := queryPath(r, types.QueryParams)
 		res, height, err := cliCtx.QueryWithData(route, nil)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -88,7 +115,7 @@ func checkpointBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// fetch checkpoint
-		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), nil)
+		result, height, err := cliCtx.QueryWithData(queryPath(r, types.QueryCheckpointBuffer), nil)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -107,7 +134,7 @@ func checkpointCountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		RestLogger.Debug("Fetching number of checkpoints from state")
-		ackCountBytes, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+		ackCountBytes, height, err := cliCtx.QueryWithData(queryPath(r, types.QueryAckCount), nil)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -158,7 +185,42 @@ func checkpointHeaderHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// fetch checkpoint
-		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint), queryParams)
+		result, height, err := cliCtx.QueryWithData(queryPath(r, types.QueryCheckpoint), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// get inclusion proof of bor block in checkpoint
+func blockProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get block number
+		blockNumber, ok := rest.ParseUint64OrReturnBadRequest(w, vars["block"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockParams(blockNumber))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// fetch proof
+		result, height, err := cliCtx.QueryWithData(queryPath(r, types.QueryBlockProof), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -200,8 +262,8 @@ func checkpointHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		// get headers
-		roothash, err := types.GetHeaders(uint64(start), uint64(end))
+		// get headers of bor chain
+		roothash, err := types.GetHeaders(r.URL.Query().Get("bor_chain_id"), uint64(start), uint64(end))
 		if err != nil {
 			RestLogger.Error("Unable to get header", "Start", start, "End", end, "Error", err)
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -250,7 +312,7 @@ func noackHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastNoAck), nil)
+		res, height, err := cliCtx.QueryWithData(queryPath(r, types.QueryLastNoAck), nil)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -301,7 +363,7 @@ func overviewHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		//
 
 		var ackCountInt uint64
-		ackCountBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+		ackCountBytes, _, err := cliCtx.QueryWithData(queryPath(r, types.QueryAckCount), nil)
 		if err == nil {
 			// check content
 			if ok := hmRest.ReturnNotFoundIfNoContent(w, ackCountBytes, "No ack count found"); ok {
@@ -317,7 +379,7 @@ func overviewHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		//
 
 		var _checkpoint *hmTypes.CheckpointBlockHeader
-		checkpointBufferBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), nil)
+		checkpointBufferBytes, _, err := cliCtx.QueryWithData(queryPath(r, types.QueryCheckpointBuffer), nil)
 		if err == nil {
 			if len(checkpointBufferBytes) != 0 {
 				_checkpoint = new(hmTypes.CheckpointBlockHeader)
@@ -350,7 +412,7 @@ func overviewHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		// last no ack
 		var lastNoACKTime uint64
-		lastNoACKBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastNoAck), nil)
+		lastNoACKBytes, _, err := cliCtx.QueryWithData(queryPath(r, types.QueryLastNoAck), nil)
 		if err == nil {
 			// check content
 			if ok := hmRest.ReturnNotFoundIfNoContent(w, lastNoACKBytes, "No last-no-ack count found"); ok {
@@ -395,7 +457,7 @@ func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		// Get ack count
 		//
 
-		ackcountBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+		ackcountBytes, _, err := cliCtx.QueryWithData(queryPath(r, types.QueryAckCount), nil)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -417,10 +479,16 @@ func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		//
 
 		RestLogger.Debug("ACK Count fetched", "ackCount", ackCount)
-		lastCheckpointKey := helper.GetConfig().ChildBlockInterval * ackCount
+		params, err := getParams(cliCtx)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		lastCheckpointKey := params.ChildBlockInterval * ackCount
 		RestLogger.Debug("Last checkpoint key generated",
 			"lastCheckpointKey", lastCheckpointKey,
-			"min", helper.GetConfig().ChildBlockInterval,
+			"min", params.ChildBlockInterval,
 		)
 
 		// get query params
@@ -433,7 +501,7 @@ func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		// Get checkpoint
 		//
 
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint), queryParams)
+		res, _, err := cliCtx.QueryWithData(queryPath(r, types.QueryCheckpoint), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -465,10 +533,16 @@ func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		RestLogger.Debug("Get Checkpoint for ", "checkpointNumber", checkpointNumber)
-		checkpointKey := helper.GetConfig().ChildBlockInterval * checkpointNumber
+		params, err := getParams(cliCtx)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		checkpointKey := params.ChildBlockInterval * checkpointNumber
 		RestLogger.Debug("checkpoint key generated",
 			"checkpointKey", checkpointKey,
-			"min", helper.GetConfig().ChildBlockInterval,
+			"min", params.ChildBlockInterval,
 		)
 
 		// get query params
@@ -478,7 +552,7 @@ func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// query checkpoint
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint), queryParams)
+		res, _, err := cliCtx.QueryWithData(queryPath(r, types.QueryCheckpoint), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -523,7 +597,7 @@ func checkpointListhandlerFn(
 		}
 
 		// query checkpoint
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointList), queryParams)
+		res, _, err := cliCtx.QueryWithData(queryPath(r, types.QueryCheckpointList), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -537,3 +611,258 @@ func checkpointListhandlerFn(
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// getParams fetches checkpoint params, child block interval is needed to build header block index
+func getParams(cliCtx context.CLIContext) (params types.Params, err error) {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+	if err != nil {
+		return params, err
+	}
+
+	err = json.Unmarshal(res, &params)
+	return params, err
+}
+
+// get checkpoint which covers bor block
+func checkpointByBlockHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get block number
+		blockNumber, ok := rest.ParseUint64OrReturnBadRequest(w, vars["block"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockParams(blockNumber))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query checkpoint
+		res, height, err := cliCtx.QueryWithData(queryPath(r, types.QueryCheckpointByBlock), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// get checkpoints which cover bor blocks from start to end
+func checkpointsByRangeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get start and end
+		start, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("start"))
+		if !ok {
+			return
+		}
+
+		end, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("end"))
+		if !ok {
+			return
+		}
+
+		// get page
+		page, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("page"))
+		if !ok {
+			return
+		}
+
+		// get limit
+		limit, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("limit"))
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockRangeParams(start, end, page, limit))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query checkpoints
+		res, height, err := cliCtx.QueryWithData(queryPath(r, types.QueryCheckpointsByRange), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoints found"); !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// get checkpoints proposed by signer
+func checkpointsByProposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get proposer
+		proposer := hmTypes.HexToHeimdallAddress(mux.Vars(r)["proposer"])
+		if proposer.Empty() {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid proposer address")
+			return
+		}
+
+		// get page
+		page, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("page"))
+		if !ok {
+			return
+		}
+
+		// get limit
+		limit, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("limit"))
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerParams(proposer, page, limit))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query checkpoints
+		res, height, err := cliCtx.QueryWithData(queryPath(r, types.QueryCheckpointsByProposer), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoints found"); !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query checkpoint stats of validator
+func validatorStatsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get validator id
+		validatorID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorStatsParams(hmTypes.NewValidatorID(validatorID)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query stats
+		res, height, err := cliCtx.QueryWithData(queryPath(r, types.QueryValidatorStats), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query checkpoint stats of all validators
+func allValidatorStatsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// query stats
+		res, height, err := cliCtx.QueryWithData(queryPath(r, types.QueryAllValidatorStats), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No validator stats found"); !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query signers of checkpoint
+func checkpointSignaturesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get header number
+		headerNumber, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["headerBlockIndex"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(headerNumber))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// fetch signatures
+		res, height, err := cliCtx.QueryWithData(queryPath(r, types.QueryCheckpointSignatures), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryPath returns path of checkpoint query, for checkpoint chain given by checkpoint_chain param
+func queryPath(r *http.Request, query string) string {
+	if chainID := r.URL.Query().Get("checkpoint_chain"); chainID != "" {
+		return fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, query, chainID)
+	}
+	return fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query)
+}
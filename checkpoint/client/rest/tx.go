@@ -32,6 +32,7 @@ type (
 		AccountRootHash hmTypes.HeimdallHash    `json:"accountRootHash"`
 		StartBlock      uint64                  `json:"startBlock"`
 		EndBlock        uint64                  `json:"endBlock"`
+		ChainID         string                  `json:"checkpoint_chain"`
 	}
 
 	// HeaderACKReq struct for sending ACK for a new headers
@@ -43,6 +44,7 @@ type (
 		HeaderBlock uint64                  `json:"headerBlock"`
 		TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
 		LogIndex    uint64                  `json:"log_index"`
+		ChainID     string                  `json:"checkpoint_chain"`
 	}
 
 	// HeaderNoACKReq struct for sending no-ack for a new headers
@@ -72,6 +74,7 @@ func newCheckpointHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			req.EndBlock,
 			req.RootHash,
 			req.AccountRootHash,
+			req.ChainID,
 		)
 
 		// send response
@@ -92,7 +95,7 @@ func newCheckpointACKHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// draft a message and send response
-		msg := types.NewMsgCheckpointAck(req.Proposer, req.HeaderBlock, req.TxHash, req.LogIndex)
+		msg := types.NewMsgCheckpointAck(req.Proposer, req.HeaderBlock, req.TxHash, req.LogIndex, req.ChainID)
 
 		// send response
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
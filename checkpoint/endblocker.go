@@ -0,0 +1,64 @@
+package checkpoint
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// EndBlocker flushes buffered checkpoint of each checkpoint chain once it stays in buffer
+// longer than checkpoint buffer time.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	for _, chain := range k.ck.GetParams(ctx).ChainParams.GetAllCheckpointChains() {
+		ck, _, err := k.ForChain(ctx, chain.ChainID)
+		if err != nil {
+			continue
+		}
+
+		checkpoint, err := ck.GetCheckpointFromBuffer(ctx)
+		if err != nil {
+			continue
+		}
+
+		if isCheckpointBufferExpired(ctx, ck, *checkpoint) {
+			expireCheckpointBuffer(ctx, ck, *checkpoint)
+		}
+	}
+}
+
+// isCheckpointBufferExpired checks if buffered checkpoint has timed out at current block time
+func isCheckpointBufferExpired(ctx sdk.Context, k Keeper, checkpoint hmTypes.CheckpointBlockHeader) bool {
+	timeStamp := uint64(ctx.BlockTime().Unix())
+	checkpointBufferTime := uint64(k.GetParams(ctx).CheckpointBufferTime.Seconds())
+
+	return checkpoint.TimeStamp == 0 || ((timeStamp > checkpoint.TimeStamp) && timeStamp-checkpoint.TimeStamp >= checkpointBufferTime)
+}
+
+// expireCheckpointBuffer flushes buffered checkpoint and records it as missed by its proposer.
+// Checkpoint could already be submitted on rootchain, so it is kept with its signatures
+// for ack until new checkpoint is proposed.
+func expireCheckpointBuffer(ctx sdk.Context, k Keeper, checkpoint hmTypes.CheckpointBlockHeader) {
+	k.Logger(ctx).Debug("Checkpoint has been timed out, flushing buffer", "CheckpointTimestamp", ctx.BlockTime().Unix(), "PrevCheckpointTimestamp", checkpoint.TimeStamp)
+
+	k.SetExpiredCheckpoint(ctx, checkpoint)
+	k.FlushCheckpointBuffer(ctx)
+
+	k.updateValidatorStatsBySigner(ctx, checkpoint.Proposer, func(stats *types.ValidatorStats) {
+		stats.Expired++
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCheckpointExpired,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProposer, checkpoint.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(checkpoint.StartBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(checkpoint.EndBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyRootHash, checkpoint.RootHash.String()),
+			sdk.NewAttribute(types.AttributeKeyChainID, k.GetChainID(ctx)),
+		),
+	)
+}
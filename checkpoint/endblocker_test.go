@@ -0,0 +1,90 @@
+package checkpoint_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/staking"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// bufferExpiredCheckpoint buffers checkpoint and expires it in end blocker
+func bufferExpiredCheckpoint(t *testing.T, ctx sdk.Context, sk staking.Keeper, ck checkpoint.Keeper) (sdk.Context, types.CheckpointBlockHeader) {
+	LoadValidatorSet(4, t, sk, ctx, false, 10)
+	sk.IncrementAccum(ctx, 1)
+
+	header := types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HeimdallHash{}, sk.GetValidatorSet(ctx).Proposer.Signer, uint64(ctx.BlockTime().Unix()))
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, header))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(ck.GetParams(ctx).CheckpointBufferTime + time.Second))
+	checkpoint.EndBlocker(ctx, ck)
+
+	_, err := ck.GetCheckpointFromBuffer(ctx)
+	require.Error(t, err, "expired checkpoint should be flushed from buffer")
+	return ctx, header
+}
+
+// sendAck sends ack for header block, with checkpoint submitted on rootchain
+func sendAck(ctx sdk.Context, ck checkpoint.Keeper, headerBlock uint64, submitted types.CheckpointBlockHeader) sdk.Result {
+	contractCallerObj := mocks.IContractCaller{}
+	contractCallerObj.On("GetRootChainInstance", mock.Anything).Return(&rootchain.Rootchain{}, nil)
+	contractCallerObj.On("GetHeaderInfo", headerBlock, mock.Anything).Return(ethcmn.Hash(submitted.RootHash), submitted.StartBlock, submitted.EndBlock, submitted.TimeStamp, submitted.Proposer, nil)
+
+	msgAck := checkpointTypes.NewMsgCheckpointAck(submitted.Proposer, headerBlock, types.HeimdallHash{}, 0, "")
+	return checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msgAck)
+}
+
+func TestAckExpiredCheckpoint(t *testing.T) {
+	ctx, sk, ck := CreateTestInput(t, false)
+	ctx, header := bufferExpiredCheckpoint(t, ctx, sk, ck)
+
+	// checkpoint mined on rootchain after it expired in buffer is still acknowledged
+	headerBlock := ck.GetParams(ctx).ChildBlockInterval
+	got := sendAck(ctx, ck, headerBlock, header)
+	require.True(t, got.IsOK(), "expected send-ack of expired checkpoint to be ok, got %v", got)
+
+	stored, err := ck.GetCheckpointByIndex(ctx, headerBlock)
+	require.NoError(t, err)
+	require.Equal(t, header.StartBlock, stored.StartBlock)
+	require.Equal(t, header.EndBlock, stored.EndBlock)
+	require.Equal(t, header.RootHash, stored.RootHash)
+	require.Equal(t, uint64(1), ck.GetACKCount(ctx))
+
+	// expired checkpoint can be acknowledged only once
+	_, err = ck.GetExpiredCheckpoint(ctx)
+	require.Error(t, err)
+}
+
+func TestAckExpiredCheckpointMismatch(t *testing.T) {
+	ctx, sk, ck := CreateTestInput(t, false)
+	ctx, header := bufferExpiredCheckpoint(t, ctx, sk, ck)
+
+	submitted := header
+	submitted.StartBlock = header.StartBlock + 1
+	got := sendAck(ctx, ck, ck.GetParams(ctx).ChildBlockInterval, submitted)
+	require.False(t, got.IsOK(), "expected send-ack of mismatched checkpoint to be not ok")
+	require.Equal(t, uint64(0), ck.GetACKCount(ctx))
+}
+
+func TestExpiredCheckpointReplacedByProposal(t *testing.T) {
+	ctx, sk, ck := CreateTestInput(t, false)
+	ctx, _ = bufferExpiredCheckpoint(t, ctx, sk, ck)
+
+	_, err := ck.GetExpiredCheckpoint(ctx)
+	require.NoError(t, err)
+
+	// new proposal replaces expired checkpoint, late ack for it is rejected
+	ck.FlushExpiredCheckpoint(ctx)
+	header := types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HeimdallHash{}, sk.GetValidatorSet(ctx).Proposer.Signer, uint64(ctx.BlockTime().Unix()))
+	got := sendAck(ctx, ck, ck.GetParams(ctx).ChildBlockInterval, header)
+	require.False(t, got.IsOK(), "expected send-ack without buffered or expired checkpoint to be not ok")
+}
@@ -2,11 +2,11 @@ package checkpoint
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
-	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -19,6 +19,34 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		keeper.SetLastNoAck(ctx, data.LastNoACK)
 	}
 
+	// Set checkpoint state of default chain
+	initChainGenesis(ctx, keeper, data.DefaultChain(), data.Params.ChildBlockInterval)
+
+	// Set checkpoint state of additional checkpoint chains
+	for _, chain := range data.Chains {
+		chainKeeper, _, err := keeper.ForChain(ctx, chain.ChainID)
+		if err != nil {
+			panic(err)
+		}
+		if chainKeeper.chainID == "" {
+			panic(fmt.Errorf("Checkpoint state of default chain %v must be set at top level", chain.ChainID))
+		}
+		initChainGenesis(ctx, chainKeeper, chain, data.Params.ChildBlockInterval)
+	}
+
+	// Set checkpoint stats of validators
+	for _, stats := range data.ValidatorStats {
+		keeper.SetValidatorStats(ctx, stats)
+	}
+
+	// Set validator whose proposer turn started with last ack or no-ack
+	if data.ProposerTurn != 0 {
+		keeper.SetProposerTurn(ctx, data.ProposerTurn)
+	}
+}
+
+// initChainGenesis sets checkpoint state of checkpoint chain selected by keeper
+func initChainGenesis(ctx sdk.Context, keeper Keeper, data types.ChainGenesisState, childBlockInterval uint64) {
 	// Add finalised checkpoints to state
 	if len(data.Headers) != 0 {
 		// check if we are provided all the headers
@@ -30,7 +58,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 		// load checkpoints to state
 		for i, header := range data.Headers {
-			checkpointHeaderIndex := helper.GetConfig().ChildBlockInterval * (uint64(i) + 1)
+			checkpointHeaderIndex := childBlockInterval * (uint64(i) + 1)
 			keeper.AddCheckpoint(ctx, checkpointHeaderIndex, header)
 		}
 	}
@@ -40,6 +68,24 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		keeper.SetCheckpointBuffer(ctx, *data.BufferedCheckpoint)
 	}
 
+	// Add checkpoint expired in buffer
+	if data.ExpiredCheckpoint != nil {
+		keeper.SetExpiredCheckpoint(ctx, *data.ExpiredCheckpoint)
+	}
+
+	// Set height and signers of buffered checkpoint
+	if data.BufferedCheckpointHeight != 0 {
+		keeper.SetBufferedCheckpointHeight(ctx, data.BufferedCheckpointHeight)
+	}
+	if data.BufferedCheckpointSignatures != nil {
+		keeper.SetBufferedCheckpointSignatures(ctx, *data.BufferedCheckpointSignatures)
+	}
+
+	// Set signers of acknowledged checkpoints
+	for _, sigs := range data.CheckpointSignatures {
+		keeper.SetCheckpointSignatures(ctx, sigs)
+	}
+
 	// Set initial ack count
 	keeper.UpdateACKCountWithValue(ctx, data.AckCount)
 }
@@ -48,12 +94,54 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 
-	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
+	defaultChain := exportChainGenesis(ctx, keeper)
+
+	var chains []types.ChainGenesisState
+	for _, chain := range keeper.ck.GetParams(ctx).ChainParams.CheckpointChains {
+		chainKeeper, _, err := keeper.ForChain(ctx, chain.ChainID)
+		if err != nil {
+			panic(err)
+		}
+		chainState := exportChainGenesis(ctx, chainKeeper)
+		chainState.ChainID = chain.ChainID
+		chains = append(chains, chainState)
+	}
+
+	proposerTurn, _ := keeper.GetProposerTurn(ctx)
 	return types.NewGenesisState(
 		params,
-		bufferedCheckpoint,
+		defaultChain.BufferedCheckpoint,
+		defaultChain.ExpiredCheckpoint,
 		keeper.GetLastNoAck(ctx),
-		keeper.GetACKCount(ctx),
-		hmTypes.SortHeaders(keeper.GetCheckpointHeaders(ctx)),
+		defaultChain.AckCount,
+		defaultChain.Headers,
+		keeper.GetAllValidatorStats(ctx),
+		proposerTurn,
+		defaultChain.BufferedCheckpointHeight,
+		defaultChain.BufferedCheckpointSignatures,
+		defaultChain.CheckpointSignatures,
+		chains,
 	)
 }
+
+// exportChainGenesis returns checkpoint state of checkpoint chain selected by keeper
+func exportChainGenesis(ctx sdk.Context, keeper Keeper) types.ChainGenesisState {
+	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
+	expiredCheckpoint, _ := keeper.GetExpiredCheckpoint(ctx)
+	bufferedCheckpointHeight, _ := keeper.GetBufferedCheckpointHeight(ctx)
+
+	var bufferedCheckpointSignatures *types.CheckpointSignatures
+	if sigs, found := keeper.GetBufferedCheckpointSignatures(ctx); found {
+		bufferedCheckpointSignatures = &sigs
+	}
+
+	return types.ChainGenesisState{
+		BufferedCheckpoint:           bufferedCheckpoint,
+		ExpiredCheckpoint:            expiredCheckpoint,
+		AckCount:                     keeper.GetACKCount(ctx),
+		Headers:                      hmTypes.SortHeaders(keeper.GetCheckpointHeaders(ctx)),
+		BufferedCheckpointHeight:     bufferedCheckpointHeight,
+		BufferedCheckpointSignatures: bufferedCheckpointSignatures,
+		CheckpointSignatures:         keeper.GetAllCheckpointSignatures(ctx),
+	}
+}
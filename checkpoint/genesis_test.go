@@ -0,0 +1,222 @@
+package checkpoint_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+func testCheckpointSignatures(height int64) checkpointTypes.CheckpointSignatures {
+	return checkpointTypes.NewCheckpointSignatures(height, []checkpointTypes.CheckpointSigner{
+		{ValidatorID: types.NewValidatorID(1), Signer: randomAddress(), VotingPower: 10, Signed: true},
+		{ValidatorID: types.NewValidatorID(2), Signer: randomAddress(), VotingPower: 10, Signed: true},
+		{ValidatorID: types.NewValidatorID(3), Signer: randomAddress(), VotingPower: 5, Signed: false},
+	})
+}
+
+func TestExportImportCheckpointSignatures(t *testing.T) {
+	ctx, _, ck := CreateTestInput(t, false)
+	interval := ck.GetParams(ctx).ChildBlockInterval
+
+	// two acknowledged checkpoints with signers, next one waits in buffer
+	addIndexedCheckpoints(t, ctx, ck, 2, randomAddress())
+	ck.UpdateACKCountWithValue(ctx, 2)
+	for i, height := range []int64{10, 20} {
+		sigs := testCheckpointSignatures(height)
+		sigs.HeaderIndex = uint64(i+1) * interval
+		ck.SetCheckpointSignatures(ctx, sigs)
+	}
+
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, types.CreateBlock(200, 299, types.HeimdallHash{}, types.HeimdallHash{}, randomAddress(), 30)))
+	ck.SetBufferedCheckpointHeight(ctx, 30)
+	ck.SetBufferedCheckpointSignatures(ctx, testCheckpointSignatures(30))
+
+	genesis := checkpoint.ExportGenesis(ctx, ck)
+	require.NoError(t, checkpointTypes.ValidateGenesis(genesis))
+	require.Equal(t, int64(30), genesis.BufferedCheckpointHeight)
+	require.NotNil(t, genesis.BufferedCheckpointSignatures)
+	require.Len(t, genesis.CheckpointSignatures, 2)
+
+	// imported state exports same buffered and acknowledged signatures
+	importedCtx, _, importedCk := CreateTestInput(t, false)
+	checkpoint.InitGenesis(importedCtx, importedCk, genesis)
+	imported := checkpoint.ExportGenesis(importedCtx, importedCk)
+	require.Equal(t, genesis.BufferedCheckpointHeight, imported.BufferedCheckpointHeight)
+	require.Equal(t, genesis.BufferedCheckpointSignatures, imported.BufferedCheckpointSignatures)
+	require.Equal(t, genesis.CheckpointSignatures, imported.CheckpointSignatures)
+
+	sigs, err := importedCk.GetCheckpointSignatures(importedCtx, 2*interval)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), sigs.Height)
+	require.True(t, sigs.ThresholdMet)
+}
+
+func TestValidateGenesisCheckpointSignatures(t *testing.T) {
+	params := checkpointTypes.DefaultParams()
+	buffered := types.CreateBlock(200, 299, types.HeimdallHash{}, types.HeimdallHash{}, randomAddress(), 30)
+	bufferedSigs := testCheckpointSignatures(30)
+	ackedSigs := testCheckpointSignatures(10)
+	ackedSigs.HeaderIndex = params.ChildBlockInterval
+
+	valid := checkpointTypes.DefaultGenesisState()
+	valid.AckCount = 1
+	valid.BufferedCheckpoint = &buffered
+	valid.BufferedCheckpointHeight = 30
+	valid.BufferedCheckpointSignatures = &bufferedSigs
+	valid.CheckpointSignatures = []checkpointTypes.CheckpointSignatures{ackedSigs}
+	require.NoError(t, checkpointTypes.ValidateGenesis(valid))
+
+	testCases := []struct {
+		name   string
+		modify func(genesis *checkpointTypes.GenesisState)
+	}{
+		{"buffered height without checkpoint", func(genesis *checkpointTypes.GenesisState) {
+			genesis.BufferedCheckpoint = nil
+		}},
+		{"buffered signatures at other height", func(genesis *checkpointTypes.GenesisState) {
+			genesis.BufferedCheckpointHeight = 31
+		}},
+		{"wrong signed power", func(genesis *checkpointTypes.GenesisState) {
+			invalid := ackedSigs
+			invalid.SignedPower = 30
+			genesis.CheckpointSignatures = []checkpointTypes.CheckpointSignatures{invalid}
+		}},
+		{"header index not acknowledged", func(genesis *checkpointTypes.GenesisState) {
+			invalid := ackedSigs
+			invalid.HeaderIndex = 2 * params.ChildBlockInterval
+			genesis.CheckpointSignatures = []checkpointTypes.CheckpointSignatures{invalid}
+		}},
+		{"duplicate header index", func(genesis *checkpointTypes.GenesisState) {
+			genesis.CheckpointSignatures = []checkpointTypes.CheckpointSignatures{ackedSigs, ackedSigs}
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesis := valid
+			tc.modify(&genesis)
+			require.Error(t, checkpointTypes.ValidateGenesis(genesis))
+		})
+	}
+}
+
+// createTestInputWithChain returns checkpoint keeper with additional checkpoint chain configured
+func createTestInputWithChain(t *testing.T, chainID string) (sdk.Context, checkpoint.Keeper) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{ChainID: "foochainid", Height: 1, Time: time.Now().UTC()})
+
+	params := happ.ChainKeeper.GetParams(ctx)
+	params.ChainParams.CheckpointChains = append(params.ChainParams.CheckpointChains, chainmanagerTypes.CheckpointChain{
+		ChainID:          chainID,
+		BorChainID:       chainID,
+		RootChainAddress: randomAddress(),
+	})
+	happ.ChainKeeper.SetParams(ctx, params)
+	return ctx, happ.CheckpointKeeper
+}
+
+func TestExportImportCheckpointChains(t *testing.T) {
+	ctx, ck := createTestInputWithChain(t, "137001")
+	interval := ck.GetParams(ctx).ChildBlockInterval
+
+	// default chain has one checkpoint, additional chain has two and one in buffer
+	addIndexedCheckpoints(t, ctx, ck, 1, randomAddress())
+	ck.UpdateACKCountWithValue(ctx, 1)
+
+	chainCk, _, err := ck.ForChain(ctx, "137001")
+	require.NoError(t, err)
+	addIndexedCheckpoints(t, ctx, chainCk, 2, randomAddress())
+	chainCk.UpdateACKCountWithValue(ctx, 2)
+	sigs := testCheckpointSignatures(20)
+	sigs.HeaderIndex = 2 * interval
+	chainCk.SetCheckpointSignatures(ctx, sigs)
+	require.NoError(t, chainCk.SetCheckpointBuffer(ctx, types.CreateBlock(200, 299, types.HeimdallHash{}, types.HeimdallHash{}, randomAddress(), 30)))
+	chainCk.SetBufferedCheckpointHeight(ctx, 30)
+
+	genesis := checkpoint.ExportGenesis(ctx, ck)
+	require.NoError(t, checkpointTypes.ValidateGenesis(genesis))
+	require.Equal(t, uint64(1), genesis.AckCount)
+	require.Len(t, genesis.Headers, 1)
+	require.Len(t, genesis.Chains, 1)
+	require.Equal(t, "137001", genesis.Chains[0].ChainID)
+	require.Equal(t, uint64(2), genesis.Chains[0].AckCount)
+	require.Len(t, genesis.Chains[0].Headers, 2)
+	require.NotNil(t, genesis.Chains[0].BufferedCheckpoint)
+	require.Equal(t, int64(30), genesis.Chains[0].BufferedCheckpointHeight)
+	require.Equal(t, []checkpointTypes.CheckpointSignatures{sigs}, genesis.Chains[0].CheckpointSignatures)
+
+	// imported state keeps chains apart
+	importedCtx, importedCk := createTestInputWithChain(t, "137001")
+	checkpoint.InitGenesis(importedCtx, importedCk, genesis)
+	require.Equal(t, genesis, checkpoint.ExportGenesis(importedCtx, importedCk))
+
+	importedChainCk, _, err := importedCk.ForChain(importedCtx, "137001")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), importedChainCk.GetACKCount(importedCtx))
+	require.Equal(t, uint64(1), importedCk.GetACKCount(importedCtx))
+}
+
+func TestInitGenesisUnknownCheckpointChain(t *testing.T) {
+	ctx, _, ck := CreateTestInput(t, false)
+
+	genesis := checkpointTypes.DefaultGenesisState()
+	genesis.Chains = []checkpointTypes.ChainGenesisState{{ChainID: "137001"}}
+	require.Panics(t, func() { checkpoint.InitGenesis(ctx, ck, genesis) })
+}
+
+func TestValidateGenesisCheckpointChains(t *testing.T) {
+	params := checkpointTypes.DefaultParams()
+	header := types.CreateBlock(0, 99, types.HeimdallHash{}, types.HeimdallHash{}, randomAddress(), 10)
+	chain := checkpointTypes.ChainGenesisState{
+		ChainID:  "137001",
+		AckCount: 1,
+		Headers:  []types.CheckpointBlockHeader{header},
+	}
+
+	valid := checkpointTypes.DefaultGenesisState()
+	valid.Chains = []checkpointTypes.ChainGenesisState{chain}
+	require.NoError(t, checkpointTypes.ValidateGenesis(valid))
+
+	testCases := []struct {
+		name   string
+		modify func(genesis *checkpointTypes.GenesisState)
+	}{
+		{"missing chain id", func(genesis *checkpointTypes.GenesisState) {
+			invalid := chain
+			invalid.ChainID = ""
+			genesis.Chains = []checkpointTypes.ChainGenesisState{invalid}
+		}},
+		{"duplicate chain", func(genesis *checkpointTypes.GenesisState) {
+			genesis.Chains = []checkpointTypes.ChainGenesisState{chain, chain}
+		}},
+		{"ack count not matching headers", func(genesis *checkpointTypes.GenesisState) {
+			invalid := chain
+			invalid.AckCount = 2
+			genesis.Chains = []checkpointTypes.ChainGenesisState{invalid}
+		}},
+		{"signatures of unknown header", func(genesis *checkpointTypes.GenesisState) {
+			sigs := testCheckpointSignatures(10)
+			sigs.HeaderIndex = 2 * params.ChildBlockInterval
+			invalid := chain
+			invalid.CheckpointSignatures = []checkpointTypes.CheckpointSignatures{sigs}
+			genesis.Chains = []checkpointTypes.ChainGenesisState{invalid}
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesis := valid
+			tc.modify(&genesis)
+			require.Error(t, checkpointTypes.ValidateGenesis(genesis))
+		})
+	}
+}
@@ -36,22 +36,37 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	timeStamp := uint64(ctx.BlockTime().Unix())
 	params := k.GetParams(ctx)
 
+	// select state of checkpoint chain
+	k, chain, err := k.ForChain(ctx, msg.ChainID)
+	if err != nil {
+		k.Logger(ctx).Error("Invalid checkpoint chain", "chainID", msg.ChainID, "error", err)
+		return common.ErrInvalidCheckpointChain(k.Codespace(), msg.ChainID).Result()
+	}
+
+	// buffer is expired in end block, but block time could have passed expiry since then
 	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
 	if err == nil {
-		checkpointBufferTime := uint64(params.CheckpointBufferTime.Seconds())
-
-		if checkpointBuffer.TimeStamp == 0 || ((timeStamp > checkpointBuffer.TimeStamp) && timeStamp-checkpointBuffer.TimeStamp >= checkpointBufferTime) {
-			k.Logger(ctx).Debug("Checkpoint has been timed out, flushing buffer", "CheckpointTimestamp", timeStamp, "PrevCheckpointTimestamp", checkpointBuffer.TimeStamp)
-			k.FlushCheckpointBuffer(ctx)
+		if isCheckpointBufferExpired(ctx, k, *checkpointBuffer) {
+			expireCheckpointBuffer(ctx, k, *checkpointBuffer)
 		} else {
-			expiryTime := checkpointBuffer.TimeStamp + checkpointBufferTime
+			expiryTime := checkpointBuffer.TimeStamp + uint64(params.CheckpointBufferTime.Seconds())
 			k.Logger(ctx).Error("Checkpoint already exits in buffer", "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime)
 			return common.ErrNoACK(k.Codespace(), expiryTime).Result()
 		}
 	}
 
+	// checkpoint length is bounded by on-chain params, so that all validators agree on it
+	if checkpointLength := msg.EndBlock - msg.StartBlock + 1; checkpointLength > params.MaxCheckpointLength {
+		k.Logger(ctx).Error("Checkpoint is too long",
+			"StartBlock", msg.StartBlock,
+			"EndBlock", msg.EndBlock,
+			"MaxCheckpointLength", params.MaxCheckpointLength)
+		return common.ErrCheckpointTooLong(k.Codespace(), checkpointLength, params.MaxCheckpointLength).Result()
+	}
+
 	// validate checkpoint
-	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash)
+	// TODO move to side-tx handler, bor headers are fetched in DeliverTx (see sidetx package)
+	validCheckpoint, err := types.ValidateCheckpoint(k.GetBorChainID(chain), msg.StartBlock, msg.EndBlock, msg.RootHash)
 	if err != nil {
 		k.Logger(ctx).Error("Error validating checkpoint",
 			"Error", err,
@@ -129,6 +144,15 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	checkpoint, _ := k.GetCheckpointFromBuffer(ctx)
 	k.Logger(ctx).Debug("Adding good checkpoint to buffer to await ACK", "checkpointStored", checkpoint.String())
 
+	k.updateValidatorStatsBySigner(ctx, msg.Proposer, func(stats *types.ValidatorStats) {
+		stats.Proposed++
+	})
+
+	// new checkpoint replaces expired one, signers of this block are recorded in next begin block
+	k.FlushExpiredCheckpoint(ctx)
+	k.flushBufferedCheckpointSignatures(ctx)
+	k.SetBufferedCheckpointHeight(ctx, ctx.BlockHeight())
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCheckpoint,
@@ -136,6 +160,7 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
 			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(uint64(msg.StartBlock), 10)),
 			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(uint64(msg.EndBlock), 10)),
+			sdk.NewAttribute(types.AttributeKeyChainID, chain.ChainID),
 		),
 	})
 
@@ -148,10 +173,22 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
 	k.Logger(ctx).Debug("Validating Checkpoint ACK", "Tx", msg)
 
-	// make call to headerBlock with header number
-	chainParams := k.ck.GetParams(ctx).ChainParams
+	// header block index must be multiple of child block interval
+	if childBlockInterval := k.GetParams(ctx).ChildBlockInterval; msg.HeaderBlock%childBlockInterval != 0 {
+		k.Logger(ctx).Error("Invalid header block index", "headerBlockIndex", msg.HeaderBlock, "childBlockInterval", childBlockInterval)
+		return common.ErrBadAck(k.Codespace()).Result()
+	}
 
-	rootChainInstance, err := contractCaller.GetRootChainInstance(chainParams.RootChainAddress.EthAddress())
+	// select state of checkpoint chain
+	k, chain, err := k.ForChain(ctx, msg.ChainID)
+	if err != nil {
+		k.Logger(ctx).Error("Invalid checkpoint chain", "chainID", msg.ChainID, "error", err)
+		return common.ErrInvalidCheckpointChain(k.Codespace(), msg.ChainID).Result()
+	}
+
+	// make call to headerBlock with header number
+	// TODO move to side-tx handler, header block is fetched from root chain in DeliverTx (see sidetx package)
+	rootChainInstance, err := contractCaller.GetRootChainInstance(chain.RootChainAddress.EthAddress())
 	if err != nil {
 		k.Logger(ctx).Error("Unable to fetch rootchain contract instance", "Error", err)
 		return common.ErrBadAck(k.Codespace()).Result()
@@ -171,11 +208,29 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 		"createdAt", createdAt,
 	)
 
-	// get last checkpoint from buffer
+	// checkpoint was signed by validator set at height it was buffered at, not at ack height
+	signedHeight, found := k.GetBufferedCheckpointHeight(ctx)
+	if !found {
+		signedHeight = ctx.BlockHeight()
+	}
+
+	// checkpoint must be submitted on rootchain by validator of set which signed it,
+	// or by new signer of that validator whose signer rotation waits for this ack
+	proposerID, ok := k.getSignedValidatorIDBySigner(ctx, proposer, signedHeight)
+	if !ok {
+		k.Logger(ctx).Error("Rootchain proposer is not a validator of checkpoint", "proposer", proposer, "headerBlockIndex", msg.HeaderBlock, "signedHeight", signedHeight)
+		return common.ErrBadAck(k.Codespace()).Result()
+	}
+
+	// get last checkpoint from buffer, or checkpoint which expired in buffer before
+	// its submission on rootchain was acknowledged
 	headerBlock, err := k.GetCheckpointFromBuffer(ctx)
 	if err != nil {
-		k.Logger(ctx).Error("Unable to get checkpoint", "error", err)
-		return common.ErrBadAck(k.Codespace()).Result()
+		if headerBlock, err = k.GetExpiredCheckpoint(ctx); err != nil {
+			k.Logger(ctx).Error("Unable to get checkpoint", "error", err)
+			return common.ErrBadAck(k.Codespace()).Result()
+		}
+		k.Logger(ctx).Info("Acknowledging expired checkpoint", "headerBlock", msg.HeaderBlock, "checkpoint", headerBlock.String())
 	}
 	if start != headerBlock.StartBlock {
 		k.Logger(ctx).Error("Invalid start block", "startExpected", headerBlock.StartBlock, "startReceived", start)
@@ -190,25 +245,42 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 			"rootRecieved", root.String())
 		return common.ErrBadAck(k.Codespace()).Result()
 	}
-	if headerBlock.EndBlock > end {
-		k.Logger(ctx).Info("Adjusting endBlock to one already submitted on chain", "OldEndBlock", headerBlock.EndBlock, "AdjustedEndBlock", end)
+	proposedEndBlock := headerBlock.EndBlock
+	adjusted := headerBlock.EndBlock > end
+	if adjusted {
+		k.Logger(ctx).Info("Adjusting endBlock to one already submitted on chain", "OldEndBlock", headerBlock.EndBlock, "AdjustedEndBlock", end, "proposer", proposer)
 		headerBlock.EndBlock = end
 		headerBlock.RootHash = hmTypes.HeimdallHash(root)
-		// TODO proposer also needs to be changed
 	}
 
+	// store checkpoint as submitted on rootchain
+	headerBlock.Proposer = proposer
+	headerBlock.TimeStamp = createdAt
+
 	// Add checkpoint to headerBlocks
 	k.AddCheckpoint(ctx, msg.HeaderBlock, *headerBlock)
 	k.Logger(ctx).Info("Checkpoint added to store", "headerBlock", headerBlock.String())
 
+	k.updateValidatorStats(ctx, proposerID, func(stats *types.ValidatorStats) {
+		stats.Acked++
+	})
+
+	k.ackCheckpointSignatures(ctx, msg.HeaderBlock)
+
 	// flush buffer
 	k.FlushCheckpointBuffer(ctx)
+	k.FlushExpiredCheckpoint(ctx)
 	k.Logger(ctx).Debug("Checkpoint buffer flushed after receiving checkpoint ack", "checkpoint", headerBlock)
 
 	// update ack count
 	k.UpdateACKCount(ctx)
 	k.Logger(ctx).Debug("Valid ack received", "CurrentACKCount", k.GetACKCount(ctx)-1, "UpdatedACKCount", k.GetACKCount(ctx))
 
+	// record validator set which signed checkpoint
+	if err := k.sk.SetCheckpointValidatorSet(ctx, k.GetChainID(ctx), msg.HeaderBlock, signedHeight); err != nil {
+		k.Logger(ctx).Error("Unable to record checkpoint validator set", "headerBlock", msg.HeaderBlock, "error", err)
+	}
+
 	// --- Update to new proposer
 
 	// increment accum
@@ -217,6 +289,7 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 	//log new proposer
 	vs := k.sk.GetValidatorSet(ctx)
 	newProposer := vs.GetProposer()
+	k.SetProposerTurn(ctx, newProposer.ID)
 	k.Logger(ctx).Debug(
 		"New proposer selected",
 		"validator", newProposer.Signer.String(),
@@ -229,6 +302,13 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 			types.EventTypeCheckpointAck,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(uint64(msg.HeaderBlock), 10)),
+			sdk.NewAttribute(types.AttributeKeyProposer, headerBlock.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(headerBlock.StartBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(headerBlock.EndBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyRootHash, headerBlock.RootHash.String()),
+			sdk.NewAttribute(types.AttributeKeyAdjusted, strconv.FormatBool(adjusted)),
+			sdk.NewAttribute(types.AttributeKeyProposedEndBlock, strconv.FormatUint(proposedEndBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyChainID, chain.ChainID),
 		),
 	})
 
@@ -269,6 +349,18 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	k.SetLastNoAck(ctx, uint64(currentTime.Unix()))
 	k.Logger(ctx).Debug("Last No-ACK time set", "LastNoAck", k.GetLastNoAck(ctx))
 
+	// validator selected after last ack or no-ack missed its turn, current proposer
+	// could be different if validator set has changed since then
+	if missedProposerID, found := k.GetProposerTurn(ctx); found {
+		k.updateValidatorStats(ctx, missedProposerID, func(stats *types.ValidatorStats) {
+			stats.NoAcks++
+		})
+	} else if missedProposer := k.sk.GetValidatorSet(ctx).Proposer; missedProposer != nil {
+		k.updateValidatorStats(ctx, missedProposer.ID, func(stats *types.ValidatorStats) {
+			stats.NoAcks++
+		})
+	}
+
 	// --- Update to new proposer
 
 	// increment accum
@@ -277,6 +369,7 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	//log new proposer
 	vs := k.sk.GetValidatorSet(ctx)
 	newProposer := vs.GetProposer()
+	k.SetProposerTurn(ctx, newProposer.ID)
 	k.Logger(ctx).Debug(
 		"New proposer selected",
 		"validator", newProposer.Signer.String(),
@@ -1,104 +1,36 @@
-package checkpoint
+package checkpoint_test
 
 import (
 	"bytes"
 	"encoding/hex"
 	"math/big"
 	"math/rand"
-	"os"
 	"testing"
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/params"
-	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 	ethcmn "github.com/maticnetwork/bor/common"
-	"github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
-	"github.com/maticnetwork/heimdall/helper/mocks"
-	"github.com/maticnetwork/heimdall/staking"
-	"github.com/maticnetwork/heimdall/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
-	"github.com/tendermint/tendermint/crypto/secp256k1"
-
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 
-	"github.com/tendermint/tendermint/libs/log"
-	dbm "github.com/tendermint/tm-db"
-
-	bankTypes "github.com/maticnetwork/heimdall/bank/types"
-	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/checkpoint"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/staking"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	"github.com/maticnetwork/heimdall/types"
 )
 
-func MakeTestCodec() *codec.Codec {
-	cdc := codec.New()
-
-	codec.RegisterCrypto(cdc)
-	sdk.RegisterCodec(cdc)
-
-	bankTypes.RegisterCodec(cdc)
-
-	// custom types
-	borTypes.RegisterCodec(cdc)
-	checkpointTypes.RegisterCodec(cdc)
-	stakingTypes.RegisterCodec(cdc)
-
-	cdc.Seal()
-	return cdc
-}
-
 // init for test cases
-func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, staking.Keeper, Keeper) {
-	helper.InitHeimdallConfig(os.ExpandEnv("$HOME/.heimdalld"))
-	db := dbm.NewMemDB()
-	ms := store.NewCommitMultiStore(db)
-
-	// TODO create more keys like borKey etc
-	keyCheckpoint := sdk.NewKVStoreKey("checkpoint")
-	keyStaking := sdk.NewKVStoreKey("staking")
-	keyMaster := sdk.NewKVStoreKey("master")
-	keyParams := sdk.NewKVStoreKey(subspace.StoreKey)
-	tKeyParams := sdk.NewTransientStoreKey(subspace.TStoreKey)
-
-	// mount all
-	ms.MountStoreWithDB(keyCheckpoint, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyMaster, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
-
-	err := ms.LoadLatestVersion()
-	require.Nil(t, err)
-
-	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid"}, isCheckTx, log.NewNopLogger())
-	cdc := MakeTestCodec()
-
-	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
-
-	dummyStakingKeeper := staking.Keeper{}
-
-	checkpointKeeper := NewKeeper(
-		cdc,
-		keyCheckpoint,
-		paramsKeeper.Subspace(checkpointTypes.DefaultParamspace),
-		common.DefaultCodespace,
-		dummyStakingKeeper,
-	)
-
-	stakingKeeper := staking.NewKeeper(
-		cdc,
-		keyStaking,
-		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
-		common.DefaultCodespace,
-		checkpointKeeper,
-	)
-	checkpointKeeper.sk = stakingKeeper
-
-	return ctx, stakingKeeper, checkpointKeeper
+func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, staking.Keeper, checkpoint.Keeper) {
+	happ := app.Setup(isCheckTx)
+	ctx := happ.BaseApp.NewContext(isCheckTx, abci.Header{ChainID: "foochainid", Height: 1, Time: time.Now().UTC()})
+	return ctx, happ.StakingKeeper, happ.CheckpointKeeper
 }
 
 // Load Validator Set
@@ -149,6 +81,7 @@ func PrintVals(t *testing.T, valset *types.ValidatorSet) {
 
 // test handler for message
 func TestHandleMsgCheckpoint(t *testing.T) {
+	RequireBorRPC(t)
 	contractCallerObj := mocks.IContractCaller{}
 
 	// check valid checkpoint
@@ -161,7 +94,7 @@ func TestHandleMsgCheckpoint(t *testing.T) {
 		require.Empty(t, err, "Unable to create random header block, Error:%v", err)
 		// make sure proposer has min ether
 		contractCallerObj.On("GetBalance", sk.GetValidatorSet(ctx).Proposer.Signer).Return(helper.MinBalance, nil)
-		SentValidCheckpoint(header, ck, sk, ctx, contractCallerObj, t)
+		SentValidCheckpoint(header, ck, sk, ctx, &contractCallerObj, t)
 	})
 
 	// check invalid proposer
@@ -187,8 +120,8 @@ func TestHandleMsgCheckpoint(t *testing.T) {
 			header.EndBlock,
 			header.RootHash,
 			header.AccountRootHash,
-			header.TimeStamp) // send checkpoint to handler
-		got := handleMsgCheckpoint(ctx, msgCheckpoint, ck, &contractCallerObj)
+			"") // send checkpoint to handler
+		got := checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msgCheckpoint)
 		require.True(t, !got.IsOK(), "expected send-checkpoint to be not ok, got %v", got.IsOK())
 	})
 
@@ -205,24 +138,22 @@ func TestHandleMsgCheckpoint(t *testing.T) {
 			header.Proposer = sk.GetValidatorSet(ctx).Proposer.Signer
 			// make sure proposer has min ether
 			contractCallerObj.On("GetBalance", header.Proposer).Return(helper.MinBalance, nil)
-			// create checkpoint 257 seconds prev to current time
-			header.TimeStamp = uint64(time.Now().Add(-(helper.CheckpointBufferTime + time.Second)).Unix())
-			t.Log("Sending checkpoint with timestamp", "Timestamp", header.TimeStamp, "Current", time.Now().UTC().Unix())
 			// send old checkpoint
-			SentValidCheckpoint(header, ck, sk, ctx, contractCallerObj, t)
+			SentValidCheckpoint(header, ck, sk, ctx, &contractCallerObj, t)
+
+			// move block time past checkpoint buffer time
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(ck.GetParams(ctx).CheckpointBufferTime + time.Second))
 
 			header, err = GenRandCheckpointHeader(0, 10)
 			header.Proposer = sk.GetValidatorSet(ctx).Proposer.Signer
-			// create new checkpoint with current time
-			header.TimeStamp = uint64(time.Now().Unix())
 			accs := sk.GetAllDividendAccounts(ctx)
 			root, err := checkpointTypes.GetAccountRootHash(accs)
 
 			header.AccountRootHash = types.BytesToHeimdallHash(root)
 
-			msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, header.AccountRootHash, header.TimeStamp)
+			msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, header.AccountRootHash, "")
 			// send new checkpoint which should replace old one
-			got := handleMsgCheckpoint(ctx, msgCheckpoint, ck, &contractCallerObj)
+			got := checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msgCheckpoint)
 			require.True(t, got.IsOK(), "expected send-checkpoint to be  ok, got %v", got)
 		})
 
@@ -238,27 +169,209 @@ func TestHandleMsgCheckpoint(t *testing.T) {
 			header.Proposer = sk.GetValidatorSet(ctx).Proposer.Signer
 			// make sure proposer has min ether
 			contractCallerObj.On("GetBalance", header.Proposer).Return(helper.MinBalance, nil)
-			// add current proposer to header
-			header.Proposer = sk.GetValidatorSet(ctx).Proposer.Signer
 			// send old checkpoint
-			SentValidCheckpoint(header, ck, sk, ctx, contractCallerObj, t)
+			SentValidCheckpoint(header, ck, sk, ctx, &contractCallerObj, t)
 			accs := sk.GetAllDividendAccounts(ctx)
 			root, err := checkpointTypes.GetAccountRootHash(accs)
 
 			header.AccountRootHash = types.BytesToHeimdallHash(root)
 
 			// create checkpoint msg
-			msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, header.AccountRootHash, uint64(time.Now().Unix()))
+			msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, header.AccountRootHash, "")
 
 			// send checkpoint to handler
-			got := handleMsgCheckpoint(ctx, msgCheckpoint, ck, &contractCallerObj)
+			got := checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msgCheckpoint)
 			require.True(t, !got.IsOK(), "expected send-checkpoint to be not ok, got %v", got)
 		})
 	})
 
 }
 
-func SentValidCheckpoint(header types.CheckpointBlockHeader, ck Keeper, sk staking.Keeper, ctx sdk.Context, contractCallerObj mocks.IContractCaller, t *testing.T) {
+// test handler for checkpoint ack
+func TestHandleMsgCheckpointAck(t *testing.T) {
+	rootHash := types.HexToHeimdallHash("0x2ff1e4a9ec7e9ab7d6a2e3de1d1b6e5ff65c1bb0b3e4c4d3e7a3d7b1ffac2a11")
+	createdAt := uint64(1577836800)
+
+	// buffer checkpoint proposed by current proposer and return ack handler with rootchain header
+	setup := func(t *testing.T, rootchainProposer func(ctx sdk.Context, sk staking.Keeper, valSet types.ValidatorSet) types.HeimdallAddress) (sdk.Context, staking.Keeper, checkpoint.Keeper, sdk.Result, uint64, types.HeimdallAddress) {
+		ctx, sk, ck := CreateTestInput(t, false)
+		LoadValidatorSet(4, t, sk, ctx, false, 10)
+		sk.IncrementAccum(ctx, 1)
+		valSet := sk.GetValidatorSet(ctx)
+
+		header := types.CreateBlock(0, 255, rootHash, types.HeimdallHash{}, valSet.Proposer.Signer, uint64(ctx.BlockTime().Unix()))
+		require.NoError(t, ck.SetCheckpointBuffer(ctx, header))
+
+		headerBlock := ck.GetParams(ctx).ChildBlockInterval
+		proposer := rootchainProposer(ctx, sk, valSet)
+
+		contractCallerObj := mocks.IContractCaller{}
+		contractCallerObj.On("GetRootChainInstance", mock.Anything).Return(&rootchain.Rootchain{}, nil)
+		contractCallerObj.On("GetHeaderInfo", headerBlock, mock.Anything).Return(ethcmn.Hash(rootHash), uint64(0), uint64(255), createdAt, proposer, nil)
+
+		msgAck := checkpointTypes.NewMsgCheckpointAck(valSet.Proposer.Signer, headerBlock, types.HeimdallHash{}, 0, "")
+		got := checkpoint.NewHandler(ck, &contractCallerObj)(ctx, msgAck)
+		return ctx, sk, ck, got, headerBlock, proposer
+	}
+
+	t.Run("rootchainProposer", func(t *testing.T) {
+		// checkpoint submitted on rootchain by other validator than heimdall proposer
+		ctx, sk, ck, got, headerBlock, proposer := setup(t, func(ctx sdk.Context, sk staking.Keeper, valSet types.ValidatorSet) types.HeimdallAddress {
+			for _, val := range valSet.Validators {
+				if !bytes.Equal(val.Signer.Bytes(), valSet.Proposer.Signer.Bytes()) {
+					return val.Signer
+				}
+			}
+			return types.HeimdallAddress{}
+		})
+		require.True(t, got.IsOK(), "expected send-ack to be ok, got %v", got)
+
+		// proposer and timestamp are taken from rootchain
+		stored, err := ck.GetCheckpointByIndex(ctx, headerBlock)
+		require.NoError(t, err)
+		require.Equal(t, proposer, stored.Proposer)
+		require.Equal(t, createdAt, stored.TimeStamp)
+		require.Equal(t, rootHash, stored.RootHash)
+		require.Equal(t, uint64(1), ck.GetACKCount(ctx))
+
+		_, err = ck.GetCheckpointFromBuffer(ctx)
+		require.Error(t, err, "buffer should be flushed after ack")
+
+		// turn of next proposer starts with ack
+		turn, found := ck.GetProposerTurn(ctx)
+		require.True(t, found)
+		require.Equal(t, sk.GetValidatorSet(ctx).Proposer.ID, turn)
+	})
+
+	t.Run("nonValidatorProposer", func(t *testing.T) {
+		// checkpoint submitted on rootchain by address which is not a validator
+		ctx, _, ck, got, headerBlock, _ := setup(t, func(ctx sdk.Context, sk staking.Keeper, valSet types.ValidatorSet) types.HeimdallAddress {
+			return types.BytesToHeimdallAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+		})
+		require.False(t, got.IsOK(), "expected send-ack to be not ok, got %v", got)
+
+		_, err := ck.GetCheckpointByIndex(ctx, headerBlock)
+		require.Error(t, err, "checkpoint should not be stored")
+		require.Equal(t, uint64(0), ck.GetACKCount(ctx))
+
+		_, err = ck.GetCheckpointFromBuffer(ctx)
+		require.NoError(t, err, "buffer should be kept")
+	})
+
+	t.Run("pendingSignerProposer", func(t *testing.T) {
+		// checkpoint submitted on rootchain by new signer of validator whose signer rotation waits for ack
+		var validatorID types.ValidatorID
+		ctx, _, ck, got, _, _ := setup(t, func(ctx sdk.Context, sk staking.Keeper, valSet types.ValidatorSet) types.HeimdallAddress {
+			validatorID = valSet.Validators[0].ID
+			update := stakingTypes.NewPendingSignerUpdate(validatorID, types.NewPubKey(secp256k1.GenPrivKey().PubKey().Bytes()), 1)
+			require.NoError(t, sk.SetPendingSignerUpdate(ctx, update))
+			return update.NewSigner
+		})
+		require.True(t, got.IsOK(), "expected send-ack to be ok, got %v", got)
+
+		// ack is counted for validator of new signer
+		require.Equal(t, uint64(1), ck.GetValidatorStats(ctx, validatorID).Acked)
+	})
+
+	t.Run("pendingSignerOfNonValidator", func(t *testing.T) {
+		// checkpoint submitted on rootchain by new signer of validator which is not in current validator set
+		ctx, _, ck, got, _, _ := setup(t, func(ctx sdk.Context, sk staking.Keeper, valSet types.ValidatorSet) types.HeimdallAddress {
+			update := stakingTypes.NewPendingSignerUpdate(types.NewValidatorID(100), types.NewPubKey(secp256k1.GenPrivKey().PubKey().Bytes()), 1)
+			require.NoError(t, sk.SetPendingSignerUpdate(ctx, update))
+			return update.NewSigner
+		})
+		require.False(t, got.IsOK(), "expected send-ack to be not ok, got %v", got)
+		require.Equal(t, uint64(0), ck.GetACKCount(ctx))
+	})
+
+	t.Run("proposerJailedBeforeAck", func(t *testing.T) {
+		// checkpoint buffered at height 1, its proposer is jailed and removed from validator set before ack
+		ctx, sk, ck := CreateTestInput(t, false)
+		LoadValidatorSet(4, t, sk, ctx, false, 10)
+		sk.IncrementAccum(ctx, 1)
+		valSet := sk.GetValidatorSet(ctx)
+		proposer := valSet.Proposer.Copy()
+
+		header := types.CreateBlock(0, 255, rootHash, types.HeimdallHash{}, proposer.Signer, uint64(ctx.BlockTime().Unix()))
+		require.NoError(t, ck.SetCheckpointBuffer(ctx, header))
+		ck.SetBufferedCheckpointHeight(ctx, ctx.BlockHeight())
+
+		ackCtx := ctx.WithBlockHeight(5)
+		require.NoError(t, sk.JailValidator(ackCtx, proposer.ID))
+		removed := proposer.Copy()
+		removed.VotingPower = 0
+		require.NoError(t, valSet.UpdateWithChangeSet([]*types.Validator{removed}))
+		require.NoError(t, sk.UpdateValidatorSetInStore(ackCtx, valSet))
+		currentSet := sk.GetValidatorSet(ackCtx)
+		_, current := currentSet.GetByAddress(proposer.Signer.Bytes())
+		require.Nil(t, current, "jailed proposer should not be in current validator set")
+
+		headerBlock := ck.GetParams(ctx).ChildBlockInterval
+		contractCallerObj := mocks.IContractCaller{}
+		contractCallerObj.On("GetRootChainInstance", mock.Anything).Return(&rootchain.Rootchain{}, nil)
+		contractCallerObj.On("GetHeaderInfo", headerBlock, mock.Anything).Return(ethcmn.Hash(rootHash), uint64(0), uint64(255), createdAt, proposer.Signer, nil)
+
+		msgAck := checkpointTypes.NewMsgCheckpointAck(currentSet.Proposer.Signer, headerBlock, types.HeimdallHash{}, 0, "")
+		got := checkpoint.NewHandler(ck, &contractCallerObj)(ackCtx, msgAck)
+		require.True(t, got.IsOK(), "expected send-ack to be ok, got %v", got)
+
+		// proposer is checked against validator set which signed checkpoint
+		require.Equal(t, uint64(1), ck.GetACKCount(ackCtx))
+		require.Equal(t, uint64(1), ck.GetValidatorStats(ackCtx, proposer.ID).Acked)
+	})
+}
+
+// test handler for checkpoint no-ack
+func TestHandleMsgCheckpointNoAck(t *testing.T) {
+	noAck := func(ctx sdk.Context, ck checkpoint.Keeper) sdk.Result {
+		msgNoAck := checkpointTypes.NewMsgCheckpointNoAck(types.HeimdallAddress{})
+		return checkpoint.NewHandler(ck, &mocks.IContractCaller{})(ctx, msgNoAck)
+	}
+
+	t.Run("currentProposer", func(t *testing.T) {
+		// without recorded turn, current proposer missed it
+		ctx, sk, ck := CreateTestInput(t, false)
+		LoadValidatorSet(4, t, sk, ctx, false, 10)
+		sk.IncrementAccum(ctx, 1)
+		proposer := sk.GetValidatorSet(ctx).Proposer
+
+		got := noAck(ctx, ck)
+		require.True(t, got.IsOK(), "expected no-ack to be ok, got %v", got)
+		require.Equal(t, uint64(1), ck.GetValidatorStats(ctx, proposer.ID).NoAcks)
+
+		// turn of next proposer starts with no-ack
+		nextProposer := sk.GetValidatorSet(ctx).Proposer
+		turn, found := ck.GetProposerTurn(ctx)
+		require.True(t, found)
+		require.Equal(t, nextProposer.ID, turn)
+	})
+
+	t.Run("proposerOfMissedTurn", func(t *testing.T) {
+		ctx, sk, ck := CreateTestInput(t, false)
+		LoadValidatorSet(4, t, sk, ctx, false, 10)
+		sk.IncrementAccum(ctx, 1)
+
+		// no-ack selects proposer for next turn
+		require.True(t, noAck(ctx, ck).IsOK())
+		turnProposer := sk.GetValidatorSet(ctx).Proposer
+
+		// proposer changes within turn, eg. with validator set update
+		sk.IncrementAccum(ctx, 1)
+		currentProposer := sk.GetValidatorSet(ctx).Proposer
+		require.NotEqual(t, turnProposer.ID, currentProposer.ID)
+		currentStats := ck.GetValidatorStats(ctx, currentProposer.ID)
+
+		// next no-ack after buffer time blames proposer of missed turn
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(ck.GetParams(ctx).CheckpointBufferTime + time.Second))
+		got := noAck(ctx, ck)
+		require.True(t, got.IsOK(), "expected no-ack to be ok, got %v", got)
+
+		require.Equal(t, uint64(1), ck.GetValidatorStats(ctx, turnProposer.ID).NoAcks)
+		require.Equal(t, currentStats, ck.GetValidatorStats(ctx, currentProposer.ID))
+	})
+}
+
+func SentValidCheckpoint(header types.CheckpointBlockHeader, ck checkpoint.Keeper, sk staking.Keeper, ctx sdk.Context, contractCallerObj *mocks.IContractCaller, t *testing.T) {
 	// add current proposer to header
 	header.Proposer = sk.GetValidatorSet(ctx).Proposer.Signer
 
@@ -274,13 +387,13 @@ func SentValidCheckpoint(header types.CheckpointBlockHeader, ck Keeper, sk staki
 		header.EndBlock,
 		header.RootHash,
 		header.AccountRootHash,
-		header.TimeStamp,
+		"",
 	)
 
 	t.Log("Checkpoint msg created", msgCheckpoint)
 
 	// send checkpoint to handler
-	got := handleMsgCheckpoint(ctx, msgCheckpoint, ck, &contractCallerObj)
+	got := checkpoint.NewHandler(ck, contractCallerObj)(ctx, msgCheckpoint)
 	require.True(t, got.IsOK(), "expected send-checkpoint to be ok, got %v", got)
 	storedHeader, err := ck.GetCheckpointFromBuffer(ctx)
 	t.Log("Header added to buffer", storedHeader.String())
@@ -293,9 +406,8 @@ func SentValidCheckpoint(header types.CheckpointBlockHeader, ck Keeper, sk staki
 
 // create random header block
 func GenRandCheckpointHeader(start int, headerSize int) (headerBlock types.CheckpointBlockHeader, err error) {
-	start = start
 	end := start + headerSize
-	roothash, err := checkpointTypes.GetHeaders(uint64(start), uint64(end))
+	roothash, err := checkpointTypes.GetHeaders("", uint64(start), uint64(end))
 	if err != nil {
 		return headerBlock, err
 	}
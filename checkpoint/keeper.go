@@ -1,19 +1,25 @@
 package checkpoint
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/maticnetwork/heimdall/chainmanager"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	cmn "github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/params/subspace"
 	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -24,8 +30,24 @@ var (
 	BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
 	HeaderBlockKey      = []byte{0x13} // prefix key for when storing header after ACK
 	LastNoACKKey        = []byte{0x14} // key to store last no-ack
+
+	CheckpointEndBlockIndexKey = []byte{0x15} // prefix key to index checkpoints by end block
+	CheckpointProposerIndexKey = []byte{0x16} // prefix key to index checkpoints by proposer
+	ValidatorStatsKey          = []byte{0x17} // prefix key to store checkpoint stats of validators
+
+	CheckpointSignaturesKey         = []byte{0x18} // prefix key to store signatures of acknowledged checkpoints
+	BufferedCheckpointHeightKey     = []byte{0x19} // key to store height at which checkpoint was buffered
+	BufferedCheckpointSignaturesKey = []byte{0x1A} // key to store signatures of buffered checkpoint
+
+	CheckpointChainKey = []byte{0x1B} // prefix key for checkpoint state of additional checkpoint chains
+	ProposerTurnKey    = []byte{0x1C} // key to store validator selected as proposer after last ack or no-ack
+
+	ExpiredCheckpointKey = []byte{0x1D} // key to store checkpoint expired in buffer, until it is acked or replaced
 )
 
+// maxCheckpointQueryLimit is max number of checkpoints returned by paginated queries
+const maxCheckpointQueryLimit = 20
+
 // Keeper stores all related data
 type Keeper struct {
 	cdc *codec.Codec
@@ -38,6 +60,8 @@ type Keeper struct {
 	codespace sdk.CodespaceType
 	// param space
 	paramSpace subspace.Subspace
+	// checkpoint chain, empty for default bor chain and root chain
+	chainID string
 }
 
 // NewKeeper create new keeper
@@ -67,9 +91,61 @@ func (k Keeper) Codespace() sdk.CodespaceType {
 
 // Logger returns a module-specific logger
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	if k.chainID != "" {
+		return ctx.Logger().With("module", types.ModuleName, "chain", k.chainID)
+	}
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
+// ForChain returns keeper for checkpoint state of given checkpoint chain, along with the chain.
+// Empty chain id or bor chain id selects default chain, whose state is stored without prefix.
+func (k Keeper) ForChain(ctx sdk.Context, chainID string) (Keeper, chainmanagerTypes.CheckpointChain, error) {
+	chainParams := k.ck.GetParams(ctx).ChainParams
+
+	chain, ok := chainParams.GetCheckpointChain(chainID)
+	if !ok {
+		return k, chain, fmt.Errorf("Unknown checkpoint chain %v", chainID)
+	}
+
+	k.chainID = ""
+	if chain.ChainID != chainParams.BorChainID {
+		k.chainID = chain.ChainID
+	}
+
+	return k, chain, nil
+}
+
+// GetChainID returns id of checkpoint chain selected by keeper
+func (k Keeper) GetChainID(ctx sdk.Context) string {
+	if k.chainID == "" {
+		return k.ck.GetParams(ctx).ChainParams.BorChainID
+	}
+	return k.chainID
+}
+
+// GetBorChainID returns bor chain id used to fetch headers of checkpoint chain,
+// empty for default chain which is served by matic RPC
+func (k Keeper) GetBorChainID(chain chainmanagerTypes.CheckpointChain) string {
+	if k.chainID == "" {
+		return ""
+	}
+	return chain.BorChainID
+}
+
+// GetCheckpointChainStorePrefix returns store prefix for checkpoint state of additional checkpoint chain
+func GetCheckpointChainStorePrefix(chainID string) []byte {
+	prefix := append(CheckpointChainKey, byte(len(chainID)))
+	return append(prefix, []byte(chainID)...)
+}
+
+// chainStore returns store of checkpoint chain selected by keeper
+func (k *Keeper) chainStore(ctx sdk.Context) sdk.KVStore {
+	if k.chainID == "" {
+		return ctx.KVStore(k.storeKey)
+	}
+	return prefix.NewStore(ctx.KVStore(k.storeKey), GetCheckpointChainStorePrefix(k.chainID))
+}
+
 // AddCheckpoint adds checkpoint into final blocks
 func (k *Keeper) AddCheckpoint(ctx sdk.Context, headerBlockNumber uint64, headerBlock hmTypes.CheckpointBlockHeader) error {
 	key := GetHeaderKey(headerBlockNumber)
@@ -77,10 +153,19 @@ func (k *Keeper) AddCheckpoint(ctx sdk.Context, headerBlockNumber uint64, header
 	if err != nil {
 		return err
 	}
+	k.indexCheckpoint(ctx, headerBlockNumber, headerBlock)
 	k.Logger(ctx).Info("Adding good checkpoint to state", "checkpoint", headerBlock, "headerBlockNumber", headerBlockNumber)
 	return nil
 }
 
+// indexCheckpoint adds final checkpoint to end block and proposer indexes
+func (k *Keeper) indexCheckpoint(ctx sdk.Context, headerBlockNumber uint64, headerBlock hmTypes.CheckpointBlockHeader) {
+	store := k.chainStore(ctx)
+	value := sdk.Uint64ToBigEndian(headerBlockNumber)
+	store.Set(GetCheckpointEndBlockIndexKey(headerBlock.EndBlock), value)
+	store.Set(GetCheckpointProposerIndexKey(headerBlock.Proposer, headerBlockNumber), value)
+}
+
 // SetCheckpointBuffer flushes Checkpoint Buffer
 func (k *Keeper) SetCheckpointBuffer(ctx sdk.Context, headerBlock hmTypes.CheckpointBlockHeader) error {
 	err := k.addCheckpoint(ctx, BufferCheckpointKey, headerBlock)
@@ -92,7 +177,7 @@ func (k *Keeper) SetCheckpointBuffer(ctx sdk.Context, headerBlock hmTypes.Checkp
 
 // addCheckpoint adds checkpoint to store
 func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, headerBlock hmTypes.CheckpointBlockHeader) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 
 	// create Checkpoint block and marshall
 	out, err := k.cdc.MarshalBinaryBare(headerBlock)
@@ -109,7 +194,7 @@ func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, headerBlock hmTypes.
 
 // GetCheckpointByIndex to get checkpoint by header block index 10,000 ,20,000 and so on
 func (k *Keeper) GetCheckpointByIndex(ctx sdk.Context, headerIndex uint64) (hmTypes.CheckpointBlockHeader, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	headerKey := GetHeaderKey(headerIndex)
 	var _checkpoint hmTypes.CheckpointBlockHeader
 
@@ -125,9 +210,112 @@ func (k *Keeper) GetCheckpointByIndex(ctx sdk.Context, headerIndex uint64) (hmTy
 	}
 }
 
+// GetCheckpointByBlockNumber returns header index and checkpoint which covers given bor block.
+// Checkpoints are continuous, so end blocks grow with header index and binary search is used.
+func (k *Keeper) GetCheckpointByBlockNumber(ctx sdk.Context, blockNumber uint64) (uint64, hmTypes.CheckpointBlockHeader, error) {
+	childBlockInterval := k.GetParams(ctx).ChildBlockInterval
+	ackCount := k.GetACKCount(ctx)
+
+	var searchErr error
+	// first checkpoint (1-based) with end block >= block number
+	n := sort.Search(int(ackCount), func(i int) bool {
+		checkpoint, err := k.GetCheckpointByIndex(ctx, uint64(i+1)*childBlockInterval)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return checkpoint.EndBlock >= blockNumber
+	})
+	if searchErr != nil {
+		return 0, hmTypes.CheckpointBlockHeader{}, searchErr
+	}
+
+	if uint64(n) < ackCount {
+		headerIndex := uint64(n+1) * childBlockInterval
+		checkpoint, err := k.GetCheckpointByIndex(ctx, headerIndex)
+		if err != nil {
+			return 0, checkpoint, err
+		}
+
+		if checkpoint.StartBlock <= blockNumber {
+			return headerIndex, checkpoint, nil
+		}
+	}
+
+	return 0, hmTypes.CheckpointBlockHeader{}, cmn.ErrNoCheckpointFound(k.Codespace())
+}
+
+// GetCheckpointsByBlockRange returns checkpoints (with header indexes) which cover any bor block
+// from start to end, using end block index
+func (k *Keeper) GetCheckpointsByBlockRange(ctx sdk.Context, start uint64, end uint64, page uint64, limit uint64) ([]types.IndexedCheckpoint, error) {
+	store := k.chainStore(ctx)
+
+	// have max limit
+	if limit > maxCheckpointQueryLimit {
+		limit = maxCheckpointQueryLimit
+	}
+
+	// first checkpoint ending at or after start covers start
+	iterator := store.Iterator(GetCheckpointEndBlockIndexKey(start), sdk.PrefixEndBytes(CheckpointEndBlockIndexKey))
+	defer iterator.Close()
+
+	var checkpoints []types.IndexedCheckpoint
+	var skip uint64
+	if page > 0 {
+		skip = (page - 1) * limit
+	}
+	for ; iterator.Valid() && uint64(len(checkpoints)) < limit; iterator.Next() {
+		headerIndex := binary.BigEndian.Uint64(iterator.Value())
+		checkpoint, err := k.GetCheckpointByIndex(ctx, headerIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		if checkpoint.StartBlock > end {
+			break
+		}
+
+		if skip > 0 {
+			skip--
+			continue
+		}
+
+		checkpoints = append(checkpoints, types.NewIndexedCheckpoint(headerIndex, checkpoint))
+	}
+
+	return checkpoints, nil
+}
+
+// GetCheckpointsByProposer returns checkpoints (with header indexes) proposed by given signer, using proposer index
+func (k *Keeper) GetCheckpointsByProposer(ctx sdk.Context, proposer hmTypes.HeimdallAddress, page uint64, limit uint64) ([]types.IndexedCheckpoint, error) {
+	store := k.chainStore(ctx)
+
+	// have max limit
+	if limit > maxCheckpointQueryLimit {
+		limit = maxCheckpointQueryLimit
+	}
+
+	// get paginated iterator
+	iterator := hmTypes.KVStorePrefixIteratorPaginated(store, append(CheckpointProposerIndexKey, proposer.Bytes()...), uint(page), uint(limit))
+	defer iterator.Close()
+
+	var checkpoints []types.IndexedCheckpoint
+	for ; iterator.Valid(); iterator.Next() {
+		headerIndex := binary.BigEndian.Uint64(iterator.Value())
+		checkpoint, err := k.GetCheckpointByIndex(ctx, headerIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		checkpoints = append(checkpoints, types.NewIndexedCheckpoint(headerIndex, checkpoint))
+	}
+
+	return checkpoints, nil
+}
+
 // GetCheckpointList returns all checkpoints with params like page and limit
 func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) ([]hmTypes.CheckpointBlockHeader, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 
 	// create headers
 	var headers []hmTypes.CheckpointBlockHeader
@@ -153,11 +341,11 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) (
 
 // GetLastCheckpoint gets last checkpoint, headerIndex = TotalACKs * ChildBlockInterval
 func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.CheckpointBlockHeader, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	acksCount := k.GetACKCount(ctx)
 
 	// fetch last checkpoint key (NumberOfACKs * ChildBlockInterval)
-	lastCheckpointKey := helper.GetConfig().ChildBlockInterval * acksCount
+	lastCheckpointKey := k.GetParams(ctx).ChildBlockInterval * acksCount
 
 	// fetch checkpoint and unmarshall
 	var _checkpoint hmTypes.CheckpointBlockHeader
@@ -185,9 +373,20 @@ func GetHeaderKey(headerNumber uint64) []byte {
 	return append(HeaderBlockKey, headerNumberBytes...)
 }
 
+// GetCheckpointEndBlockIndexKey returns end block index key, big endian keeps end blocks ordered
+func GetCheckpointEndBlockIndexKey(endBlock uint64) []byte {
+	return append(CheckpointEndBlockIndexKey, sdk.Uint64ToBigEndian(endBlock)...)
+}
+
+// GetCheckpointProposerIndexKey returns proposer index key
+func GetCheckpointProposerIndexKey(proposer hmTypes.HeimdallAddress, headerNumber uint64) []byte {
+	key := append(CheckpointProposerIndexKey, proposer.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(headerNumber)...)
+}
+
 // HasStoreValue check if value exists in store or not
 func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	if store.Has(key) {
 		return true
 	}
@@ -196,13 +395,13 @@ func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
 
 // FlushCheckpointBuffer flushes Checkpoint Buffer
 func (k *Keeper) FlushCheckpointBuffer(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	store.Delete(BufferCheckpointKey)
 }
 
 // GetCheckpointFromBuffer gets checkpoint in buffer
 func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context) (*hmTypes.CheckpointBlockHeader, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 
 	// checkpoint block header
 	var checkpoint hmTypes.CheckpointBlockHeader
@@ -216,6 +415,31 @@ func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context) (*hmTypes.CheckpointBl
 	return nil, errors.New("No checkpoint found in buffer")
 }
 
+// SetExpiredCheckpoint stores checkpoint which expired in buffer. It could already be submitted
+// on rootchain, so it is kept for ack until new checkpoint is proposed.
+func (k *Keeper) SetExpiredCheckpoint(ctx sdk.Context, headerBlock hmTypes.CheckpointBlockHeader) error {
+	return k.addCheckpoint(ctx, ExpiredCheckpointKey, headerBlock)
+}
+
+// GetExpiredCheckpoint returns checkpoint which expired in buffer
+func (k *Keeper) GetExpiredCheckpoint(ctx sdk.Context) (*hmTypes.CheckpointBlockHeader, error) {
+	store := k.chainStore(ctx)
+
+	var checkpoint hmTypes.CheckpointBlockHeader
+	if store.Has(ExpiredCheckpointKey) {
+		err := k.cdc.UnmarshalBinaryBare(store.Get(ExpiredCheckpointKey), &checkpoint)
+		return &checkpoint, err
+	}
+
+	return nil, errors.New("No expired checkpoint found")
+}
+
+// FlushExpiredCheckpoint removes checkpoint which expired in buffer
+func (k *Keeper) FlushExpiredCheckpoint(ctx sdk.Context) {
+	store := k.chainStore(ctx)
+	store.Delete(ExpiredCheckpointKey)
+}
+
 // SetLastNoAck set last no-ack object
 func (k *Keeper) SetLastNoAck(ctx sdk.Context, timestamp uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -239,9 +463,24 @@ func (k *Keeper) GetLastNoAck(ctx sdk.Context) uint64 {
 	return 0
 }
 
+// SetProposerTurn stores validator selected as checkpoint proposer after ack or no-ack
+func (k *Keeper) SetProposerTurn(ctx sdk.Context, validatorID hmTypes.ValidatorID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(ProposerTurnKey, sdk.Uint64ToBigEndian(uint64(validatorID)))
+}
+
+// GetProposerTurn returns validator whose proposer turn started with last ack or no-ack
+func (k *Keeper) GetProposerTurn(ctx sdk.Context) (hmTypes.ValidatorID, bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(ProposerTurnKey) {
+		return 0, false
+	}
+	return hmTypes.ValidatorID(binary.BigEndian.Uint64(store.Get(ProposerTurnKey))), true
+}
+
 // GetCheckpointHeaders get checkpoint headers
 func (k *Keeper) GetCheckpointHeaders(ctx sdk.Context) []hmTypes.CheckpointBlockHeader {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	// get checkpoint header iterator
 	iterator := sdk.KVStorePrefixIterator(store, HeaderBlockKey)
 	defer iterator.Close()
@@ -265,7 +504,7 @@ func (k *Keeper) GetCheckpointHeaders(ctx sdk.Context) []hmTypes.CheckpointBlock
 
 // GetACKCount returns current ACK count
 func (k Keeper) GetACKCount(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	// check if ack count is there
 	if store.Has(ACKCountKey) {
 		// get current ACK count
@@ -282,7 +521,7 @@ func (k Keeper) GetACKCount(ctx sdk.Context) uint64 {
 
 // UpdateACKCountWithValue updates ACK with value
 func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 
 	// convert
 	ackCount := []byte(strconv.FormatUint(value, 10))
@@ -293,7 +532,7 @@ func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64) {
 
 // UpdateACKCount updates ACK count by 1
 func (k Keeper) UpdateACKCount(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 
 	// get current ACK Count
 	ACKCount := k.GetACKCount(ctx)
@@ -305,6 +544,210 @@ func (k Keeper) UpdateACKCount(ctx sdk.Context) {
 	store.Set(ACKCountKey, ACKs)
 }
 
+//
+// Validator stats
+//
+
+// GetValidatorStatsKey returns validator stats key
+func GetValidatorStatsKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(ValidatorStatsKey, sdk.Uint64ToBigEndian(uint64(validatorID))...)
+}
+
+// SetValidatorStats stores checkpoint stats of validator
+func (k *Keeper) SetValidatorStats(ctx sdk.Context, stats types.ValidatorStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorStatsKey(stats.ValidatorID), k.cdc.MustMarshalBinaryBare(stats))
+}
+
+// GetValidatorStats returns checkpoint stats of validator, empty stats if validator has none
+func (k *Keeper) GetValidatorStats(ctx sdk.Context, validatorID hmTypes.ValidatorID) types.ValidatorStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorStatsKey(validatorID))
+	if bz == nil {
+		return types.NewValidatorStats(validatorID)
+	}
+
+	var stats types.ValidatorStats
+	k.cdc.MustUnmarshalBinaryBare(bz, &stats)
+	return stats
+}
+
+// GetAllValidatorStats returns checkpoint stats of all validators
+func (k *Keeper) GetAllValidatorStats(ctx sdk.Context) (stats []types.ValidatorStats) {
+	k.IterateValidatorStatsAndApplyFn(ctx, func(s types.ValidatorStats) error {
+		stats = append(stats, s)
+		return nil
+	})
+	return
+}
+
+// IterateValidatorStatsAndApplyFn iterates validator stats and applies the given function.
+func (k *Keeper) IterateValidatorStatsAndApplyFn(ctx sdk.Context, f func(stats types.ValidatorStats) error) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorStatsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.ValidatorStats
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stats)
+
+		// call function and return if required
+		if err := f(stats); err != nil {
+			return
+		}
+	}
+}
+
+// updateValidatorStats applies update to stats of validator
+func (k *Keeper) updateValidatorStats(ctx sdk.Context, validatorID hmTypes.ValidatorID, update func(stats *types.ValidatorStats)) {
+	stats := k.GetValidatorStats(ctx, validatorID)
+	update(&stats)
+	k.SetValidatorStats(ctx, stats)
+}
+
+// updateValidatorStatsBySigner applies update to stats of validator with given signer
+func (k *Keeper) updateValidatorStatsBySigner(ctx sdk.Context, signer hmTypes.HeimdallAddress, update func(stats *types.ValidatorStats)) {
+	validator, err := k.sk.GetValidatorInfo(ctx, signer.Bytes())
+	if err != nil {
+		k.Logger(ctx).Error("Unable to update checkpoint stats, validator not found", "signer", signer, "error", err)
+		return
+	}
+
+	k.updateValidatorStats(ctx, validator.ID, update)
+}
+
+// getSignedValidatorIDBySigner returns ID of validator with signer in validator set at height, or of validator
+// in that set whose staged signer rotation has signer as new signer. Current validator set is used
+// if no snapshot is stored for height.
+func (k *Keeper) getSignedValidatorIDBySigner(ctx sdk.Context, signer hmTypes.HeimdallAddress, height int64) (hmTypes.ValidatorID, bool) {
+	var validators []stakingTypes.SnapshotValidator
+	if snapshot, ok := k.sk.GetValidatorSetSnapshot(ctx, height); ok {
+		validators = snapshot.Validators
+	} else {
+		for _, validator := range k.sk.GetValidatorSet(ctx).Validators {
+			validators = append(validators, stakingTypes.SnapshotValidator{ID: validator.ID, Signer: validator.Signer, VotingPower: validator.VotingPower})
+		}
+	}
+
+	for _, validator := range validators {
+		if bytes.Equal(validator.Signer.Bytes(), signer.Bytes()) {
+			return validator.ID, true
+		}
+	}
+
+	update, ok := k.sk.GetPendingSignerUpdateBySigner(ctx, signer.Bytes())
+	if !ok {
+		return 0, false
+	}
+
+	for _, validator := range validators {
+		if validator.ID == update.ValidatorID {
+			return validator.ID, true
+		}
+	}
+
+	return 0, false
+}
+
+//
+// Checkpoint signatures
+//
+
+// GetCheckpointSignaturesKey returns checkpoint signatures key
+func GetCheckpointSignaturesKey(headerIndex uint64) []byte {
+	return append(CheckpointSignaturesKey, sdk.Uint64ToBigEndian(headerIndex)...)
+}
+
+// SetBufferedCheckpointHeight stores height of block which included buffered checkpoint
+func (k *Keeper) SetBufferedCheckpointHeight(ctx sdk.Context, height int64) {
+	store := k.chainStore(ctx)
+	store.Set(BufferedCheckpointHeightKey, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetBufferedCheckpointHeight returns height of block which included buffered checkpoint
+func (k *Keeper) GetBufferedCheckpointHeight(ctx sdk.Context) (int64, bool) {
+	store := k.chainStore(ctx)
+	bz := store.Get(BufferedCheckpointHeightKey)
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// SetBufferedCheckpointSignatures stores signatures of buffered checkpoint
+func (k *Keeper) SetBufferedCheckpointSignatures(ctx sdk.Context, sigs types.CheckpointSignatures) {
+	store := k.chainStore(ctx)
+	store.Set(BufferedCheckpointSignaturesKey, k.cdc.MustMarshalBinaryBare(sigs))
+}
+
+// GetBufferedCheckpointSignatures returns signatures of buffered checkpoint
+func (k *Keeper) GetBufferedCheckpointSignatures(ctx sdk.Context) (types.CheckpointSignatures, bool) {
+	store := k.chainStore(ctx)
+	bz := store.Get(BufferedCheckpointSignaturesKey)
+	if bz == nil {
+		return types.CheckpointSignatures{}, false
+	}
+
+	var sigs types.CheckpointSignatures
+	k.cdc.MustUnmarshalBinaryBare(bz, &sigs)
+	return sigs, true
+}
+
+// flushBufferedCheckpointSignatures removes height and signatures of buffered checkpoint
+func (k *Keeper) flushBufferedCheckpointSignatures(ctx sdk.Context) {
+	store := k.chainStore(ctx)
+	store.Delete(BufferedCheckpointHeightKey)
+	store.Delete(BufferedCheckpointSignaturesKey)
+}
+
+// SetCheckpointSignatures stores signatures of acknowledged checkpoint
+func (k *Keeper) SetCheckpointSignatures(ctx sdk.Context, sigs types.CheckpointSignatures) {
+	store := k.chainStore(ctx)
+	store.Set(GetCheckpointSignaturesKey(sigs.HeaderIndex), k.cdc.MustMarshalBinaryBare(sigs))
+}
+
+// GetCheckpointSignatures returns signatures of acknowledged checkpoint
+func (k *Keeper) GetCheckpointSignatures(ctx sdk.Context, headerIndex uint64) (types.CheckpointSignatures, error) {
+	store := k.chainStore(ctx)
+	bz := store.Get(GetCheckpointSignaturesKey(headerIndex))
+	if bz == nil {
+		return types.CheckpointSignatures{}, errors.New("No signatures found for checkpoint")
+	}
+
+	var sigs types.CheckpointSignatures
+	k.cdc.MustUnmarshalBinaryBare(bz, &sigs)
+	return sigs, nil
+}
+
+// GetAllCheckpointSignatures returns signatures of all acknowledged checkpoints
+func (k *Keeper) GetAllCheckpointSignatures(ctx sdk.Context) (allSigs []types.CheckpointSignatures) {
+	store := k.chainStore(ctx)
+
+	iterator := sdk.KVStorePrefixIterator(store, CheckpointSignaturesKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sigs types.CheckpointSignatures
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &sigs)
+		allSigs = append(allSigs, sigs)
+	}
+	return
+}
+
+// ackCheckpointSignatures moves signatures of buffered checkpoint to acknowledged header index
+func (k *Keeper) ackCheckpointSignatures(ctx sdk.Context, headerIndex uint64) {
+	sigs, found := k.GetBufferedCheckpointSignatures(ctx)
+	k.flushBufferedCheckpointSignatures(ctx)
+	if !found {
+		k.Logger(ctx).Debug("No signatures recorded for acknowledged checkpoint", "headerIndex", headerIndex)
+		return
+	}
+
+	sigs.HeaderIndex = headerIndex
+	k.SetCheckpointSignatures(ctx, sigs)
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
@@ -0,0 +1,123 @@
+package checkpoint_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// addIndexedCheckpoints adds continuous checkpoints of 100 blocks each, proposed by given proposers in turn
+func addIndexedCheckpoints(t *testing.T, ctx sdk.Context, ck checkpoint.Keeper, count int, proposers ...types.HeimdallAddress) {
+	childBlockInterval := ck.GetParams(ctx).ChildBlockInterval
+	for i := 0; i < count; i++ {
+		start := uint64(i) * 100
+		header := types.CreateBlock(start, start+99, types.HeimdallHash{}, types.HeimdallHash{}, proposers[i%len(proposers)], uint64(i))
+		require.NoError(t, ck.AddCheckpoint(ctx, uint64(i+1)*childBlockInterval, header))
+	}
+}
+
+func headerIndexes(checkpoints []checkpointTypes.IndexedCheckpoint) []uint64 {
+	indexes := make([]uint64, 0, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		indexes = append(indexes, checkpoint.HeaderIndex)
+	}
+	return indexes
+}
+
+func randomAddress() types.HeimdallAddress {
+	return types.BytesToHeimdallAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+}
+
+func TestGetCheckpointsByBlockRange(t *testing.T) {
+	ctx, _, ck := CreateTestInput(t, false)
+	interval := ck.GetParams(ctx).ChildBlockInterval
+
+	// checkpoints [0-99], [100-199], [200-299], [300-399], [400-499]
+	addIndexedCheckpoints(t, ctx, ck, 5, randomAddress())
+
+	testCases := []struct {
+		name        string
+		start, end  uint64
+		page, limit uint64
+		expected    []uint64
+	}{
+		{"inside range", 150, 250, 1, 10, []uint64{2 * interval, 3 * interval}},
+		{"exact bounds", 100, 199, 1, 10, []uint64{2 * interval}},
+		{"across bound", 199, 200, 1, 10, []uint64{2 * interval, 3 * interval}},
+		{"first block", 0, 0, 1, 10, []uint64{interval}},
+		{"last block", 499, 499, 1, 10, []uint64{5 * interval}},
+		{"all", 0, 1000, 1, 10, []uint64{interval, 2 * interval, 3 * interval, 4 * interval, 5 * interval}},
+		{"after last checkpoint", 500, 600, 1, 10, []uint64{}},
+		{"first page", 0, 499, 1, 2, []uint64{interval, 2 * interval}},
+		{"last page", 0, 499, 3, 2, []uint64{5 * interval}},
+		{"page past end", 0, 499, 4, 2, []uint64{}},
+		{"page past range end", 100, 299, 2, 2, []uint64{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkpoints, err := ck.GetCheckpointsByBlockRange(ctx, tc.start, tc.end, tc.page, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, headerIndexes(checkpoints))
+		})
+	}
+
+	// indexed checkpoints are returned with stored checkpoint data
+	checkpoints, err := ck.GetCheckpointsByBlockRange(ctx, 250, 250, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 1)
+	stored, err := ck.GetCheckpointByIndex(ctx, 3*interval)
+	require.NoError(t, err)
+	require.Equal(t, stored, checkpoints[0].CheckpointBlockHeader)
+}
+
+func TestGetCheckpointsByBlockRangeEmpty(t *testing.T) {
+	ctx, _, ck := CreateTestInput(t, false)
+
+	checkpoints, err := ck.GetCheckpointsByBlockRange(ctx, 0, 1000, 1, 10)
+	require.NoError(t, err)
+	require.Empty(t, checkpoints)
+}
+
+func TestGetCheckpointsByProposer(t *testing.T) {
+	ctx, _, ck := CreateTestInput(t, false)
+	interval := ck.GetParams(ctx).ChildBlockInterval
+
+	proposerA := randomAddress()
+	proposerB := randomAddress()
+
+	// proposers A, B, A, B, A
+	addIndexedCheckpoints(t, ctx, ck, 5, proposerA, proposerB)
+
+	testCases := []struct {
+		name        string
+		proposer    types.HeimdallAddress
+		page, limit uint64
+		expected    []uint64
+	}{
+		{"proposer A", proposerA, 1, 10, []uint64{interval, 3 * interval, 5 * interval}},
+		{"proposer B", proposerB, 1, 10, []uint64{2 * interval, 4 * interval}},
+		{"first page", proposerA, 1, 2, []uint64{interval, 3 * interval}},
+		{"last page", proposerA, 2, 2, []uint64{5 * interval}},
+		{"page past end", proposerA, 3, 2, []uint64{}},
+		{"unknown proposer", randomAddress(), 1, 10, []uint64{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkpoints, err := ck.GetCheckpointsByProposer(ctx, tc.proposer, tc.page, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, headerIndexes(checkpoints))
+
+			for _, checkpoint := range checkpoints {
+				require.Equal(t, tc.proposer, checkpoint.Proposer)
+			}
+		})
+	}
+}
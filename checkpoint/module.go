@@ -156,11 +156,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 }
 
 // BeginBlock returns the begin blocker for the auth module.
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	BeginBlocker(ctx, req, am.keeper)
+}
 
 // EndBlock returns the end blocker for the auth module. It returns no validator
 // updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -182,10 +185,10 @@ func verifyGenesis(state types.GenesisState, chainManagerState chainmanagerTypes
 		return nil
 	}
 
-	if state.AckCount*helper.GetConfig().ChildBlockInterval != currentHeaderIndex {
+	if state.AckCount*state.Params.ChildBlockInterval != currentHeaderIndex {
 		fmt.Println("Header Count doesn't match",
 			"ExpectedHeader", currentHeaderIndex,
-			"HeaderIndexFound", state.AckCount*helper.GetConfig().ChildBlockInterval)
+			"HeaderIndexFound", state.AckCount*state.Params.ChildBlockInterval)
 		return nil
 	}
 
@@ -194,7 +197,7 @@ func verifyGenesis(state types.GenesisState, chainManagerState chainmanagerTypes
 	// check all headers
 	for i, header := range state.Headers {
 		ackCount := uint64(i + 1)
-		root, start, end, _, _, err := contractCaller.GetHeaderInfo(ackCount*helper.GetConfig().ChildBlockInterval, rootChainInstance)
+		root, start, end, _, _, err := contractCaller.GetHeaderInfo(ackCount*state.Params.ChildBlockInterval, rootChainInstance)
 		if err != nil {
 			return err
 		}
@@ -1,13 +1,14 @@
 package checkpoint
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/staking"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -16,6 +17,17 @@ import (
 // NewQuerier creates a querier for auth REST endpoints
 func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		// checkpoint chain is selected by optional path suffix, default chain otherwise
+		chainID := ""
+		if len(path) > 1 {
+			chainID = path[1]
+		}
+
+		keeper, chain, err := keeper.ForChain(ctx, chainID)
+		if err != nil {
+			return nil, common.ErrInvalidCheckpointChain(keeper.Codespace(), chainID)
+		}
+
 		switch path[0] {
 		case types.QueryParams:
 			return handleQueryParams(ctx, req, keeper)
@@ -30,7 +42,21 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper) sdk.Querier {
 		case types.QueryCheckpointList:
 			return handleQueryCheckpointList(ctx, req, keeper)
 		case types.QueryNextCheckpoint:
-			return handleQueryNextCheckpoint(ctx, req, keeper, stakingKeeper)
+			return handleQueryNextCheckpoint(ctx, req, keeper, chain, stakingKeeper)
+		case types.QueryBlockProof:
+			return handleQueryBlockProof(ctx, req, keeper, chain)
+		case types.QueryCheckpointByBlock:
+			return handleQueryCheckpointByBlock(ctx, req, keeper)
+		case types.QueryCheckpointsByRange:
+			return handleQueryCheckpointsByRange(ctx, req, keeper)
+		case types.QueryCheckpointsByProposer:
+			return handleQueryCheckpointsByProposer(ctx, req, keeper)
+		case types.QueryValidatorStats:
+			return handleQueryValidatorStats(ctx, req, keeper)
+		case types.QueryAllValidatorStats:
+			return handleQueryAllValidatorStats(ctx, req, keeper)
+		case types.QueryCheckpointSignatures:
+			return handleQueryCheckpointSignatures(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -117,14 +143,15 @@ func handleQueryCheckpointList(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
-func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, sk staking.Keeper) ([]byte, sdk.Error) {
+func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, chain chainmanagerTypes.CheckpointChain, sk staking.Keeper) ([]byte, sdk.Error) {
 	// get validator set
 	validatorSet := sk.GetValidatorSet(ctx)
 	proposer := validatorSet.GetProposer()
 	ackCount := keeper.GetACKCount(ctx)
+	params := keeper.GetParams(ctx)
 	var start uint64
 	if ackCount != 0 {
-		headerIndex := (ackCount) * (helper.GetConfig().ChildBlockInterval)
+		headerIndex := (ackCount) * (params.ChildBlockInterval)
 		lastCheckpoint, err := keeper.GetCheckpointByIndex(ctx, headerIndex)
 		if err != nil {
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint by index %v", headerIndex), err.Error()))
@@ -132,8 +159,8 @@ func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 		start = lastCheckpoint.EndBlock + 1
 	}
 
-	end := start + helper.GetConfig().AvgCheckpointLength
-	rootHash, err := types.GetHeaders(start, end)
+	end := start + params.AvgCheckpointLength
+	rootHash, err := types.GetHeaders(keeper.GetBorChainID(chain), start, end)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch headers for start:%v end:%v error:%v", start, end, err), err.Error()))
 	}
@@ -142,10 +169,132 @@ func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not get generate account root hash. Error:%v", err), err.Error()))
 	}
-	checkpointMsg := types.NewMsgCheckpointBlock(proposer.Signer, start, start+helper.GetConfig().AvgCheckpointLength, hmTypes.BytesToHeimdallHash(rootHash), hmTypes.BytesToHeimdallHash(accRootHash))
+	checkpointMsg := types.NewMsgCheckpointBlock(proposer.Signer, start, end, hmTypes.BytesToHeimdallHash(rootHash), hmTypes.BytesToHeimdallHash(accRootHash), keeper.chainID)
 	bz, err := json.Marshal(checkpointMsg)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not marshall checkpoint msg. Error:%v", err), err.Error()))
 	}
 	return bz, nil
 }
+
+func handleQueryBlockProof(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, chain chainmanagerTypes.CheckpointChain) ([]byte, sdk.Error) {
+	var params types.QueryBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	headerIndex, checkpoint, err := keeper.GetCheckpointByBlockNumber(ctx, params.BlockNumber)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not find checkpoint for block %v", params.BlockNumber), err.Error()))
+	}
+
+	proof, err := types.GetBlockProof(keeper.GetBorChainID(chain), checkpoint.StartBlock, checkpoint.EndBlock, params.BlockNumber)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not generate proof for block %v", params.BlockNumber), err.Error()))
+	}
+
+	// headers fetched from bor must match checkpoint submitted on rootchain
+	if !bytes.Equal(proof.RootHash.Bytes(), checkpoint.RootHash.Bytes()) {
+		return nil, sdk.ErrInternal(fmt.Sprintf("generated root %v doesn't match checkpoint root %v", proof.RootHash, checkpoint.RootHash))
+	}
+	proof.HeaderIndex = headerIndex
+
+	bz, err := json.Marshal(proof)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointByBlock(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	headerIndex, checkpoint, err := keeper.GetCheckpointByBlockNumber(ctx, params.BlockNumber)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not find checkpoint for block %v", params.BlockNumber), err.Error()))
+	}
+
+	bz, err := json.Marshal(types.NewIndexedCheckpoint(headerIndex, checkpoint))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointsByRange(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBlockRangeParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res, err := keeper.GetCheckpointsByBlockRange(ctx, params.StartBlock, params.EndBlock, params.Page, params.Limit)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoints for blocks %v to %v", params.StartBlock, params.EndBlock), err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointsByProposer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposerParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res, err := keeper.GetCheckpointsByProposer(ctx, params.Proposer, params.Page, params.Limit)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoints for proposer %v", params.Proposer), err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorStatsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := json.Marshal(keeper.GetValidatorStats(ctx, params.ValidatorID))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAllValidatorStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetAllValidatorStats(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointSignatures(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res, err := keeper.GetCheckpointSignatures(ctx, params.HeaderIndex)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch signatures for checkpoint %v", params.HeaderIndex), err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
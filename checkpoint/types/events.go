@@ -6,11 +6,18 @@ var (
 	EventTypeCheckpointAck   = "checkpoint-ack"
 	EventTypeCheckpointNoAck = "checkpoint-noack"
 
+	EventTypeCheckpointExpired = "checkpoint-expired"
+
 	AttributeKeyProposer    = "proposer"
 	AttributeKeyStartBlock  = "start-block"
 	AttributeKeyEndBlock    = "end-block"
 	AttributeKeyHeaderIndex = "header-index"
 	AttributeKeyNewProposer = "new-proposer"
+	AttributeKeyRootHash    = "root-hash"
+	AttributeKeyAdjusted    = "adjusted"
+	AttributeKeyChainID     = "chain-id"
+
+	AttributeKeyProposedEndBlock = "proposed-end-block"
 
 	AttributeValueCategory = ModuleName
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -13,25 +14,77 @@ type GenesisState struct {
 	Params Params `json:"params" yaml:"params"`
 
 	BufferedCheckpoint *hmTypes.CheckpointBlockHeader  `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
+	ExpiredCheckpoint  *hmTypes.CheckpointBlockHeader  `json:"expired_checkpoint,omitempty" yaml:"expired_checkpoint,omitempty"` // expired in buffer, kept for ack
 	LastNoACK          uint64                          `json:"last_no_ack" yaml:"last_no_ack"`
 	AckCount           uint64                          `json:"ack_count" yaml:"ack_count"`
 	Headers            []hmTypes.CheckpointBlockHeader `json:"headers" yaml:"headers"`
+	ValidatorStats     []ValidatorStats                `json:"validator_stats" yaml:"validator_stats"`
+	ProposerTurn       hmTypes.ValidatorID             `json:"proposer_turn,omitempty" yaml:"proposer_turn,omitempty"` // validator selected after last ack or no-ack
+
+	BufferedCheckpointHeight     int64                  `json:"buffered_checkpoint_height,omitempty" yaml:"buffered_checkpoint_height,omitempty"`         // block which included buffered checkpoint
+	BufferedCheckpointSignatures *CheckpointSignatures  `json:"buffered_checkpoint_signatures,omitempty" yaml:"buffered_checkpoint_signatures,omitempty"` // recorded in block after buffered height
+	CheckpointSignatures         []CheckpointSignatures `json:"checkpoint_signatures" yaml:"checkpoint_signatures"`                                       // signers of acknowledged checkpoints
+
+	Chains []ChainGenesisState `json:"chains" yaml:"chains"` // state of additional checkpoint chains
+}
+
+// ChainGenesisState is the checkpoint state of a single checkpoint chain
+type ChainGenesisState struct {
+	ChainID string `json:"chain_id" yaml:"chain_id"`
+
+	BufferedCheckpoint *hmTypes.CheckpointBlockHeader  `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
+	ExpiredCheckpoint  *hmTypes.CheckpointBlockHeader  `json:"expired_checkpoint,omitempty" yaml:"expired_checkpoint,omitempty"`
+	AckCount           uint64                          `json:"ack_count" yaml:"ack_count"`
+	Headers            []hmTypes.CheckpointBlockHeader `json:"headers" yaml:"headers"`
+
+	BufferedCheckpointHeight     int64                  `json:"buffered_checkpoint_height,omitempty" yaml:"buffered_checkpoint_height,omitempty"`
+	BufferedCheckpointSignatures *CheckpointSignatures  `json:"buffered_checkpoint_signatures,omitempty" yaml:"buffered_checkpoint_signatures,omitempty"`
+	CheckpointSignatures         []CheckpointSignatures `json:"checkpoint_signatures" yaml:"checkpoint_signatures"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
 	params Params,
 	bufferedCheckpoint *hmTypes.CheckpointBlockHeader,
+	expiredCheckpoint *hmTypes.CheckpointBlockHeader,
 	lastNoACK uint64,
 	ackCount uint64,
 	headers []hmTypes.CheckpointBlockHeader,
+	validatorStats []ValidatorStats,
+	proposerTurn hmTypes.ValidatorID,
+	bufferedCheckpointHeight int64,
+	bufferedCheckpointSignatures *CheckpointSignatures,
+	checkpointSignatures []CheckpointSignatures,
+	chains []ChainGenesisState,
 ) GenesisState {
 	return GenesisState{
 		Params:             params,
 		BufferedCheckpoint: bufferedCheckpoint,
+		ExpiredCheckpoint:  expiredCheckpoint,
 		LastNoACK:          lastNoACK,
 		AckCount:           ackCount,
 		Headers:            headers,
+		ValidatorStats:     validatorStats,
+		ProposerTurn:       proposerTurn,
+
+		BufferedCheckpointHeight:     bufferedCheckpointHeight,
+		BufferedCheckpointSignatures: bufferedCheckpointSignatures,
+		CheckpointSignatures:         checkpointSignatures,
+
+		Chains: chains,
+	}
+}
+
+// DefaultChain returns checkpoint state of default chain, which is kept at top level of genesis
+func (data GenesisState) DefaultChain() ChainGenesisState {
+	return ChainGenesisState{
+		BufferedCheckpoint:           data.BufferedCheckpoint,
+		ExpiredCheckpoint:            data.ExpiredCheckpoint,
+		AckCount:                     data.AckCount,
+		Headers:                      data.Headers,
+		BufferedCheckpointHeight:     data.BufferedCheckpointHeight,
+		BufferedCheckpointSignatures: data.BufferedCheckpointSignatures,
+		CheckpointSignatures:         data.CheckpointSignatures,
 	}
 }
 
@@ -49,12 +102,81 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	seen := make(map[hmTypes.ValidatorID]bool)
+	for _, stats := range data.ValidatorStats {
+		if seen[stats.ValidatorID] {
+			return fmt.Errorf("Duplicate checkpoint stats for validator %v", stats.ValidatorID)
+		}
+		seen[stats.ValidatorID] = true
+	}
+
+	if err := validateChainGenesis(data.DefaultChain(), data.Params.ChildBlockInterval); err != nil {
+		return err
+	}
+
+	seenChains := make(map[string]bool)
+	for _, chain := range data.Chains {
+		if chain.ChainID == "" {
+			return errors.New("Checkpoint chain state without chain id")
+		}
+		if seenChains[chain.ChainID] {
+			return fmt.Errorf("Duplicate checkpoint state for chain %v", chain.ChainID)
+		}
+		seenChains[chain.ChainID] = true
+
+		if err := validateChainGenesis(chain, data.Params.ChildBlockInterval); err != nil {
+			return fmt.Errorf("Invalid checkpoint state for chain %v: %v", chain.ChainID, err)
+		}
+	}
+
+	return nil
+}
+
+// validateChainGenesis validates checkpoint state of a single checkpoint chain
+func validateChainGenesis(data ChainGenesisState, childBlockInterval uint64) error {
 	if len(data.Headers) != 0 {
 		if int(data.AckCount) != len(data.Headers) {
 			return errors.New("Incorrect state in state-dump , Please Check")
 		}
 	}
 
+	if data.BufferedCheckpointHeight != 0 && data.BufferedCheckpoint == nil && data.ExpiredCheckpoint == nil {
+		return errors.New("Buffered checkpoint height without buffered or expired checkpoint")
+	}
+
+	if data.BufferedCheckpointSignatures != nil {
+		if data.BufferedCheckpointSignatures.Height != data.BufferedCheckpointHeight {
+			return fmt.Errorf("Buffered checkpoint signatures recorded at height %v, checkpoint buffered at %v", data.BufferedCheckpointSignatures.Height, data.BufferedCheckpointHeight)
+		}
+		if err := validateCheckpointSignatures(*data.BufferedCheckpointSignatures); err != nil {
+			return err
+		}
+	}
+
+	seenHeaderIndexes := make(map[uint64]bool)
+	for _, sigs := range data.CheckpointSignatures {
+		if sigs.HeaderIndex == 0 || sigs.HeaderIndex%childBlockInterval != 0 || sigs.HeaderIndex/childBlockInterval > data.AckCount {
+			return fmt.Errorf("Checkpoint signatures for unknown header index %v", sigs.HeaderIndex)
+		}
+		if seenHeaderIndexes[sigs.HeaderIndex] {
+			return fmt.Errorf("Duplicate checkpoint signatures for header index %v", sigs.HeaderIndex)
+		}
+		seenHeaderIndexes[sigs.HeaderIndex] = true
+
+		if err := validateCheckpointSignatures(sigs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateCheckpointSignatures checks that signed and total power match signers
+func validateCheckpointSignatures(sigs CheckpointSignatures) error {
+	expected := NewCheckpointSignatures(sigs.Height, sigs.Signers)
+	if sigs.SignedPower != expected.SignedPower || sigs.TotalPower != expected.TotalPower || sigs.ThresholdMet != expected.ThresholdMet {
+		return fmt.Errorf("Checkpoint signatures at height %v do not match signers", sigs.Height)
+	}
 	return nil
 }
 
@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/cbergoon/merkletree"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/maticnetwork/bor/common/hexutil"
 	"github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/bor/crypto"
@@ -19,15 +22,44 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
-// ValidateCheckpoint - Validates if checkpoint rootHash matches or not
-func ValidateCheckpoint(start uint64, end uint64, rootHash hmTypes.HeimdallHash) (bool, error) {
+const (
+	headerBatchSize      = 64                     // max headers requested in single rpc batch call
+	maxConcurrentBatches = 8                      // max batch calls in flight
+	batchCallAttempts    = 3                      // attempts of each batch call before giving up
+	batchRetryDelay      = 500 * time.Millisecond // delay before retrying failed batch call, grows with attempts
+
+	leafCacheSize          = 4 * DefaultMaxCheckpointLength // number of recent header leaves kept in cache
+	leafCacheConfirmations = 256                            // blocks on top of bor block before its leaf is cached
+)
+
+// leafCache keeps recently computed header leaves by bor chain and block number. It is
+// in-memory and per-process, so bridge and heimdall compute leaves of a checkpoint on their own;
+// it saves refetching headers when the same range is hashed again in that process
+// (e.g. after checkpoint expires or is replaced).
+// Cached leaves are never invalidated, so only leaves of blocks which are deep enough
+// not to be reorged are cached.
+var leafCache, _ = lru.New(int(leafCacheSize))
+
+// leafCacheKey is key of header leaf in cache
+type leafCacheKey struct {
+	borChainID string
+	number     uint64
+}
+
+// ValidateCheckpoint - Validates if checkpoint rootHash matches or not, empty bor chain id is default chain
+func ValidateCheckpoint(borChainID string, start uint64, end uint64, rootHash hmTypes.HeimdallHash) (bool, error) {
+	// bor chain without RPC endpoint can't be validated
+	if _, err := helper.GetBorRPCClient(borChainID); err != nil {
+		return false, err
+	}
+
 	// Check if blocks exist locally
-	if !CheckIfBlocksExist(end) {
+	if !CheckIfBlocksExist(borChainID, end) {
 		return false, errors.New("blocks not found locally")
 	}
 
 	// Compare RootHash
-	root, err := GetHeaders(start, end)
+	root, err := GetHeaders(borChainID, start, end)
 	if err != nil {
 		return false, err
 	}
@@ -40,12 +72,15 @@ func ValidateCheckpoint(start uint64, end uint64, rootHash hmTypes.HeimdallHash)
 }
 
 // CheckIfBlocksExist - check if latest block number is greater than end block
-func CheckIfBlocksExist(end uint64) bool {
+func CheckIfBlocksExist(borChainID string, end uint64) bool {
 	// Get Latest block number.
-	rpcClient := helper.GetMaticRPCClient()
-	var latestBlock *types.Header
+	rpcClient, err := helper.GetBorRPCClient(borChainID)
+	if err != nil {
+		return false
+	}
 
-	err := rpcClient.Call(&latestBlock, "eth_getBlockByNumber", "latest", false)
+	var latestBlock *types.Header
+	err = rpcClient.Call(&latestBlock, "eth_getBlockByNumber", "latest", false)
 	if err != nil {
 		return false
 	}
@@ -57,21 +92,137 @@ func CheckIfBlocksExist(end uint64) bool {
 	return true
 }
 
-func GetHeaders(start uint64, end uint64) ([]byte, error) {
-	rpcClient := helper.GetMaticRPCClient()
+// GetHeaders returns checkpoint root hash of bor headers from start to end
+func GetHeaders(borChainID string, start uint64, end uint64) ([]byte, error) {
+	rpcClient, err := helper.GetBorRPCClient(borChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	leaves, err := getLeaves(rpcClient, borChainID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := getLeafTree(leaves)
+	if err != nil {
+		return nil, err
+	}
+
+	return tree.Root().Hash, nil
+}
 
+// getLeaves returns header leaves from start to end, only headers missing in cache are fetched
+func getLeaves(rpcClient *rpc.Client, borChainID string, start uint64, end uint64) ([][32]byte, error) {
 	if start > end {
 		return nil, errors.New("start is greater than end")
 	}
 
-	batchElements := make([]rpc.BatchElem, end-start+1)
-	for i := range batchElements {
-		param := new(big.Int)
-		param.SetUint64(uint64(i) + start)
+	leaves := make([][32]byte, end-start+1)
+	var missing []uint64
+	for i := range leaves {
+		if leaf, ok := leafCache.Get(leafCacheKey{borChainID, start + uint64(i)}); ok {
+			leaves[i] = leaf.([32]byte)
+		} else {
+			missing = append(missing, start+uint64(i))
+		}
+	}
+
+	if len(missing) == 0 {
+		return leaves, nil
+	}
+
+	// latest block is fetched before headers, so that cached headers were already final when fetched
+	var latest hexutil.Uint64
+	if err := rpcClient.Call(&latest, "eth_blockNumber"); err != nil {
+		return nil, err
+	}
+
+	blockHeaders, err := fetchHeadersByNumber(rpcClient, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, blockHeader := range blockHeaders {
+		leaf := getHeaderLeaf(blockHeader)
+		leaves[missing[i]-start] = leaf
+		if missing[i]+leafCacheConfirmations <= uint64(latest) {
+			leafCache.Add(leafCacheKey{borChainID, missing[i]}, leaf)
+		}
+	}
 
+	return leaves, nil
+}
+
+// GetBlockProof returns merkle proof of block header in checkpoint from start to end,
+// in the format used by rootchain exit contracts
+func GetBlockProof(borChainID string, start uint64, end uint64, blockNumber uint64) (BlockProof, error) {
+	if blockNumber < start || blockNumber > end {
+		return BlockProof{}, errors.New("block is not in checkpoint range")
+	}
+
+	rpcClient, err := helper.GetBorRPCClient(borChainID)
+	if err != nil {
+		return BlockProof{}, err
+	}
+
+	blockHeaders, err := fetchHeaders(rpcClient, start, end)
+	if err != nil {
+		return BlockProof{}, err
+	}
+
+	tree, err := getHeaderTree(blockHeaders)
+	if err != nil {
+		return BlockProof{}, err
+	}
+
+	return getBlockProof(tree, blockHeaders, blockNumber-start), nil
+}
+
+// getBlockProof builds proof of leaf at index from checkpoint header tree
+func getBlockProof(tree *merkle.Tree, blockHeaders []*types.Header, index uint64) BlockProof {
+	// sibling at each level from leaves to root, tree is perfect since leaves are padded to power of two
+	var branch [][]byte
+	for h, i := tree.Height(), index; h > 1; h, i = h-1, i/2 {
+		branch = append(branch, tree.GetNodesAtHeight(h)[i^1].Hash)
+	}
+
+	blockHeader := blockHeaders[index]
+	return BlockProof{
+		StartBlock:  blockHeaders[0].Number.Uint64(),
+		EndBlock:    blockHeaders[len(blockHeaders)-1].Number.Uint64(),
+		RootHash:    hmTypes.BytesToHeimdallHash(tree.Root().Hash),
+		BlockNumber: blockHeader.Number.Uint64(),
+		BlockTime:   blockHeader.Time,
+		TxRoot:      hmTypes.BytesToHeimdallHash(blockHeader.TxHash.Bytes()),
+		ReceiptRoot: hmTypes.BytesToHeimdallHash(blockHeader.ReceiptHash.Bytes()),
+		Leaf:        hmTypes.BytesToHeimdallHash(tree.Leaves()[index].Hash),
+		LeafIndex:   index,
+		Proof:       hexutil.Bytes(appendBytes32(branch...)),
+	}
+}
+
+// fetchHeaders fetches bor headers from start to end
+func fetchHeaders(rpcClient *rpc.Client, start uint64, end uint64) ([]*types.Header, error) {
+	if start > end {
+		return nil, errors.New("start is greater than end")
+	}
+
+	numbers := make([]uint64, end-start+1)
+	for i := range numbers {
+		numbers[i] = start + uint64(i)
+	}
+
+	return fetchHeadersByNumber(rpcClient, numbers)
+}
+
+// fetchHeadersByNumber fetches bor headers without transactions for given block numbers
+func fetchHeadersByNumber(rpcClient *rpc.Client, numbers []uint64) ([]*types.Header, error) {
+	batchElements := make([]rpc.BatchElem, len(numbers))
+	for i, number := range numbers {
 		batchElements[i] = rpc.BatchElem{
 			Method: "eth_getBlockByNumber",
-			Args:   []interface{}{hexutil.EncodeBig(param), true},
+			Args:   []interface{}{hexutil.EncodeBig(new(big.Int).SetUint64(number)), false},
 			Result: &types.Header{},
 		}
 	}
@@ -82,35 +233,51 @@ func GetHeaders(start uint64, end uint64) ([]byte, error) {
 		return nil, err
 	}
 
-	// Fetch result and draft header and add into tree
-	expectedLength := nextPowerOfTwo(end - start + 1)
-	headers := make([][32]byte, expectedLength)
+	blockHeaders := make([]*types.Header, len(batchElements))
 	for i, batchElement := range batchElements {
-		if batchElement.Error != nil {
-			return nil, batchElement.Error
+		blockHeader := batchElement.Result.(*types.Header)
+		if blockHeader.Number == nil || blockHeader.Number.Uint64() != numbers[i] {
+			return nil, fmt.Errorf("block %v not found", numbers[i])
 		}
 
-		blockHeader := batchElement.Result.(*types.Header)
-		header := crypto.Keccak256(appendBytes32(
-			blockHeader.Number.Bytes(),
-			new(big.Int).SetUint64(blockHeader.Time).Bytes(),
-			blockHeader.TxHash.Bytes(),
-			blockHeader.ReceiptHash.Bytes(),
-		))
+		blockHeaders[i] = blockHeader
+	}
+
+	return blockHeaders, nil
+}
 
-		var arr [32]byte
-		copy(arr[:], header)
+// getHeaderLeaf returns checkpoint merkle tree leaf of bor header
+func getHeaderLeaf(blockHeader *types.Header) (leaf [32]byte) {
+	copy(leaf[:], crypto.Keccak256(appendBytes32(
+		blockHeader.Number.Bytes(),
+		new(big.Int).SetUint64(blockHeader.Time).Bytes(),
+		blockHeader.TxHash.Bytes(),
+		blockHeader.ReceiptHash.Bytes(),
+	)))
+	return
+}
 
-		// set header
-		headers[i] = arr
+// getHeaderTree builds checkpoint merkle tree over bor headers
+func getHeaderTree(blockHeaders []*types.Header) (*merkle.Tree, error) {
+	leaves := make([][32]byte, len(blockHeaders))
+	for i, blockHeader := range blockHeaders {
+		leaves[i] = getHeaderLeaf(blockHeader)
 	}
 
+	return getLeafTree(leaves)
+}
+
+// getLeafTree builds checkpoint merkle tree over header leaves, padded to power of two
+func getLeafTree(leaves [][32]byte) (*merkle.Tree, error) {
+	headers := make([][32]byte, nextPowerOfTwo(uint64(len(leaves))))
+	copy(headers, leaves)
+
 	tree := merkle.NewTreeWithOpts(merkle.TreeOptions{EnableHashSorting: false, DisableHashLeaves: true})
 	if err := tree.Generate(convert(headers), sha3.NewLegacyKeccak256()); err != nil {
 		return nil, err
 	}
 
-	return tree.Root().Hash, nil
+	return &tree, nil
 }
 
 // GetAccountRootHash returns roothash of Validator Account State Tree
@@ -230,34 +397,55 @@ func nextPowerOfTwo(n uint64) uint64 {
 	return n
 }
 
-// spins go-routines to fetch batch elements to allow creation of large merkle trees
-func fetchBatchElements(rpcClient *rpc.Client, elements []rpc.BatchElem) (err error) {
-	var batchLength = int(helper.GetConfig().AvgCheckpointLength)
-	// group
+// fetchBatchElements fetches batch elements in chunks of headerBatchSize, with bounded
+// concurrency so that rpc request size limits are respected for large checkpoints
+func fetchBatchElements(rpcClient *rpc.Client, elements []rpc.BatchElem) error {
 	var g errgroup.Group
+	sem := make(chan struct{}, maxConcurrentBatches)
 
-	for i := 0; i < len(elements); i += batchLength {
-		var newBatch []rpc.BatchElem
-		if len(elements) < i+batchLength {
-			newBatch = elements[i:]
-		} else {
-			newBatch = elements[i : i+batchLength]
+	for i := 0; i < len(elements); i += headerBatchSize {
+		end := i + headerBatchSize
+		if end > len(elements) {
+			end = len(elements)
 		}
-
-		// common.CheckpointLogger.Info("Batching requests", "index", i, "length", len(newBatch))
+		batch := elements[i:end]
 
 		// spawn go-routine
+		sem <- struct{}{}
 		g.Go(func() error {
-			// Batch call
-			err := rpcClient.BatchCall(newBatch)
-			return err
+			defer func() { <-sem }()
+			return batchCallWithRetry(rpcClient, batch)
 		})
 	}
 
-	if err := g.Wait(); err != nil {
-		return err
+	return g.Wait()
+}
+
+// batchCallWithRetry makes batch call, retrying if call or any of its elements fail
+func batchCallWithRetry(rpcClient *rpc.Client, batch []rpc.BatchElem) (err error) {
+	for attempt := 1; attempt <= batchCallAttempts; attempt++ {
+		if err = rpcClient.BatchCall(batch); err == nil {
+			err = batchElementsError(batch)
+		}
+
+		if err == nil {
+			return nil
+		}
+
+		if attempt < batchCallAttempts {
+			time.Sleep(time.Duration(attempt) * batchRetryDelay)
+		}
 	}
 
-	// common.CheckpointLogger.Info("Fetched all headers", "len", len(elements))
+	return err
+}
+
+// batchElementsError returns first error of batch elements
+func batchElementsError(batch []rpc.BatchElem) error {
+	for _, element := range batch {
+		if element.Error != nil {
+			return element.Error
+		}
+	}
 	return nil
 }
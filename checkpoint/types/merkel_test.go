@@ -0,0 +1,121 @@
+package types
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/rpc"
+	"github.com/stretchr/testify/require"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// fakeBorChain serves bor headers over eth rpc methods used by checkpoint
+type fakeBorChain struct {
+	mu       sync.Mutex
+	latest   uint64
+	version  byte // changes header contents, as a reorg does
+	requests int  // headers requested
+}
+
+func (c *fakeBorChain) header(number uint64) *types.Header {
+	return &types.Header{
+		Number:      new(big.Int).SetUint64(number),
+		Time:        1000 + number,
+		TxHash:      common.BytesToHash([]byte{byte(number), c.version, 1}),
+		ReceiptHash: common.BytesToHash([]byte{byte(number), c.version, 2}),
+		Difficulty:  big.NewInt(1),
+		Extra:       []byte{},
+	}
+}
+
+func (c *fakeBorChain) BlockNumber() hexutil.Uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return hexutil.Uint64(c.latest)
+}
+
+func (c *fakeBorChain) GetBlockByNumber(number rpc.BlockNumber, fullTx bool) *types.Header {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests++
+	return c.header(uint64(number))
+}
+
+func (c *fakeBorChain) reorg() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.version++
+}
+
+func newFakeBorClient(t *testing.T, chain *fakeBorChain) *rpc.Client {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", chain))
+	return rpc.DialInProc(server)
+}
+
+func expectedLeaves(chain *fakeBorChain, start uint64, end uint64) (leaves [][32]byte) {
+	chain.mu.Lock()
+	defer chain.mu.Unlock()
+	for i := start; i <= end; i++ {
+		leaves = append(leaves, getHeaderLeaf(chain.header(i)))
+	}
+	return
+}
+
+func TestGetLeavesAfterReorg(t *testing.T) {
+	chain := &fakeBorChain{latest: 1000}
+	client := newFakeBorClient(t, chain)
+	defer client.Close()
+
+	// blocks near head can still be reorged, so their leaves are not cached
+	start, end := uint64(990), uint64(995)
+	leaves, err := getLeaves(client, "reorg-recent", start, end)
+	require.NoError(t, err)
+	require.Equal(t, expectedLeaves(chain, start, end), leaves)
+
+	chain.reorg()
+	leaves, err = getLeaves(client, "reorg-recent", start, end)
+	require.NoError(t, err)
+	require.Equal(t, expectedLeaves(chain, start, end), leaves, "leaves of reorged blocks should be fetched again")
+}
+
+func TestGetLeavesCachesFinalBlocks(t *testing.T) {
+	chain := &fakeBorChain{latest: 1000}
+	client := newFakeBorClient(t, chain)
+	defer client.Close()
+
+	// last final block is latest - confirmations
+	final := chain.latest - leafCacheConfirmations
+	start, end := final-2, final+2
+
+	leaves, err := getLeaves(client, "reorg-final", start, end)
+	require.NoError(t, err)
+	require.Equal(t, expectedLeaves(chain, start, end), leaves)
+	require.Equal(t, 5, chain.requests)
+
+	// only blocks after last final block are fetched again
+	leaves, err = getLeaves(client, "reorg-final", start, end)
+	require.NoError(t, err)
+	require.Equal(t, expectedLeaves(chain, start, end), leaves)
+	require.Equal(t, 7, chain.requests)
+}
+
+func TestCheckpointChainWithoutRPC(t *testing.T) {
+	// headers of checkpoint chain without RPC endpoint are never fetched from default chain
+	valid, err := ValidateCheckpoint("99999", 0, 255, hmTypes.HexToHeimdallHash("0x01"))
+	require.Error(t, err)
+	require.False(t, valid)
+
+	_, err = GetHeaders("99999", 0, 255)
+	require.Error(t, err)
+
+	_, err = GetBlockProof("99999", 0, 255, 10)
+	require.Error(t, err)
+
+	require.False(t, CheckIfBlocksExist("99999", 0))
+}
@@ -2,8 +2,11 @@ package types
 
 import (
 	"bytes"
+	"errors"
+	"io"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/rlp"
 
 	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
@@ -23,6 +26,7 @@ type MsgCheckpoint struct {
 	EndBlock        uint64                `json:"endBlock"`
 	RootHash        types.HeimdallHash    `json:"rootHash"`
 	AccountRootHash types.HeimdallHash    `json:"accountRootHash"`
+	ChainID         string                `json:"chain_id,omitempty"` // checkpoint chain, empty for default chain
 }
 
 // NewMsgCheckpointBlock creates new checkpoint message using mentioned arguments
@@ -32,6 +36,7 @@ func NewMsgCheckpointBlock(
 	endBlock uint64,
 	roothash types.HeimdallHash,
 	accountRootHash types.HeimdallHash,
+	chainID string,
 ) MsgCheckpoint {
 	return MsgCheckpoint{
 		Proposer:        proposer,
@@ -39,6 +44,7 @@ func NewMsgCheckpointBlock(
 		EndBlock:        endBlock,
 		RootHash:        roothash,
 		AccountRootHash: accountRootHash,
+		ChainID:         chainID,
 	}
 }
 
@@ -80,6 +86,45 @@ func (msg MsgCheckpoint) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// msgCheckpointRLP is rlp layout of checkpoint msg. Chain id is an optional tail element,
+// so checkpoints of default chain are encoded exactly as before checkpoint chains.
+type msgCheckpointRLP struct {
+	Proposer        types.HeimdallAddress
+	StartBlock      uint64
+	EndBlock        uint64
+	RootHash        types.HeimdallHash
+	AccountRootHash types.HeimdallHash
+	ChainID         []string `rlp:"tail"`
+}
+
+// EncodeRLP implements rlp.Encoder
+func (msg MsgCheckpoint) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, msgCheckpointRLP{
+		Proposer:        msg.Proposer,
+		StartBlock:      msg.StartBlock,
+		EndBlock:        msg.EndBlock,
+		RootHash:        msg.RootHash,
+		AccountRootHash: msg.AccountRootHash,
+		ChainID:         chainIDTail(msg.ChainID),
+	})
+}
+
+// DecodeRLP implements rlp.Decoder
+func (msg *MsgCheckpoint) DecodeRLP(s *rlp.Stream) error {
+	var dec msgCheckpointRLP
+	if err := s.Decode(&dec); err != nil {
+		return err
+	}
+
+	chainID, err := chainIDFromTail(dec.ChainID)
+	if err != nil {
+		return err
+	}
+
+	*msg = NewMsgCheckpointBlock(dec.Proposer, dec.StartBlock, dec.EndBlock, dec.RootHash, dec.AccountRootHash, chainID)
+	return nil
+}
+
 //
 // Msg Checkpoint Ack
 //
@@ -92,14 +137,16 @@ type MsgCheckpointAck struct {
 	HeaderBlock uint64                `json:"headerBlock"`
 	TxHash      types.HeimdallHash    `json:"tx_hash"`
 	LogIndex    uint64                `json:"log_index"`
+	ChainID     string                `json:"chain_id,omitempty"` // checkpoint chain, empty for default chain
 }
 
-func NewMsgCheckpointAck(from types.HeimdallAddress, headerBlock uint64, txHash types.HeimdallHash, logIndex uint64) MsgCheckpointAck {
+func NewMsgCheckpointAck(from types.HeimdallAddress, headerBlock uint64, txHash types.HeimdallHash, logIndex uint64, chainID string) MsgCheckpointAck {
 	return MsgCheckpointAck{
 		From:        from,
 		HeaderBlock: headerBlock,
 		TxHash:      txHash,
 		LogIndex:    logIndex,
+		ChainID:     chainID,
 	}
 }
 
@@ -128,11 +175,6 @@ func (msg MsgCheckpointAck) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
 	}
 
-	childBlockInterval := helper.GetConfig().ChildBlockInterval
-	if msg.HeaderBlock > 0 && msg.HeaderBlock%childBlockInterval != 0 {
-		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid header block %d", msg.HeaderBlock)
-	}
-
 	return nil
 }
 
@@ -146,6 +188,62 @@ func (msg MsgCheckpointAck) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
 
+// msgCheckpointAckRLP is rlp layout of checkpoint ack msg, with optional chain id tail
+type msgCheckpointAckRLP struct {
+	From        types.HeimdallAddress
+	HeaderBlock uint64
+	TxHash      types.HeimdallHash
+	LogIndex    uint64
+	ChainID     []string `rlp:"tail"`
+}
+
+// EncodeRLP implements rlp.Encoder
+func (msg MsgCheckpointAck) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, msgCheckpointAckRLP{
+		From:        msg.From,
+		HeaderBlock: msg.HeaderBlock,
+		TxHash:      msg.TxHash,
+		LogIndex:    msg.LogIndex,
+		ChainID:     chainIDTail(msg.ChainID),
+	})
+}
+
+// DecodeRLP implements rlp.Decoder
+func (msg *MsgCheckpointAck) DecodeRLP(s *rlp.Stream) error {
+	var dec msgCheckpointAckRLP
+	if err := s.Decode(&dec); err != nil {
+		return err
+	}
+
+	chainID, err := chainIDFromTail(dec.ChainID)
+	if err != nil {
+		return err
+	}
+
+	*msg = NewMsgCheckpointAck(dec.From, dec.HeaderBlock, dec.TxHash, dec.LogIndex, chainID)
+	return nil
+}
+
+// chainIDTail returns rlp tail for chain id, empty for default chain
+func chainIDTail(chainID string) []string {
+	if chainID == "" {
+		return nil
+	}
+	return []string{chainID}
+}
+
+// chainIDFromTail returns chain id from rlp tail
+func chainIDFromTail(tail []string) (string, error) {
+	switch len(tail) {
+	case 0:
+		return "", nil
+	case 1:
+		return tail[0], nil
+	default:
+		return "", errors.New("rlp: too many elements for chain id")
+	}
+}
+
 //
 // Msg Checkpoint No Ack
 //
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/maticnetwork/bor/rlp"
+	"github.com/stretchr/testify/require"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// legacyMsgCheckpoint is checkpoint msg layout before checkpoint chains
+type legacyMsgCheckpoint struct {
+	Proposer        types.HeimdallAddress
+	StartBlock      uint64
+	EndBlock        uint64
+	RootHash        types.HeimdallHash
+	AccountRootHash types.HeimdallHash
+}
+
+// legacyMsgCheckpointAck is checkpoint ack msg layout before checkpoint chains
+type legacyMsgCheckpointAck struct {
+	From        types.HeimdallAddress
+	HeaderBlock uint64
+	TxHash      types.HeimdallHash
+	LogIndex    uint64
+}
+
+// legacyTxBytes encodes tx of given msg type the way pulp did before checkpoint chains
+func legacyTxBytes(t *testing.T, name string, msg interface{}) []byte {
+	msgBytes, err := rlp.EncodeToBytes(msg)
+	require.NoError(t, err)
+
+	txBytes, err := rlp.EncodeToBytes(authTypes.StdTxRaw{
+		Msg:       msgBytes,
+		Signature: authTypes.StdSignature([]byte{1, 2, 3}),
+		Memo:      "memo",
+	})
+	require.NoError(t, err)
+	return append(authTypes.GetPulpHash(name), txBytes...)
+}
+
+func newTestPulp() *authTypes.Pulp {
+	pulp := authTypes.NewPulp()
+	RegisterPulp(pulp)
+	return pulp
+}
+
+func TestMsgCheckpointLegacyRLP(t *testing.T) {
+	pulp := newTestPulp()
+	legacy := legacyMsgCheckpoint{
+		Proposer:        types.HexToHeimdallAddress("0x0000000000000000000000000000000000000001"),
+		StartBlock:      256,
+		EndBlock:        511,
+		RootHash:        types.HexToHeimdallHash("0x01"),
+		AccountRootHash: types.HexToHeimdallHash("0x02"),
+	}
+	txBytes := legacyTxBytes(t, "checkpoint::checkpoint", legacy)
+
+	// old tx decodes to msg of default chain
+	decoded, err := pulp.DecodeBytes(txBytes)
+	require.NoError(t, err)
+	tx := decoded.(authTypes.StdTx)
+	msg := NewMsgCheckpointBlock(legacy.Proposer, legacy.StartBlock, legacy.EndBlock, legacy.RootHash, legacy.AccountRootHash, "")
+	require.Equal(t, msg, tx.Msg)
+
+	// msg of default chain is encoded byte-for-byte as before
+	encoded, err := pulp.EncodeToBytes(tx)
+	require.NoError(t, err)
+	require.Equal(t, txBytes, encoded)
+
+	// chain id is kept for other checkpoint chains
+	msg.ChainID = "15001"
+	encoded, err = pulp.EncodeToBytes(authTypes.NewStdTx(msg, tx.Signature, tx.Memo))
+	require.NoError(t, err)
+	decoded, err = pulp.DecodeBytes(encoded)
+	require.NoError(t, err)
+	require.Equal(t, msg, decoded.(authTypes.StdTx).Msg)
+}
+
+func TestMsgCheckpointAckLegacyRLP(t *testing.T) {
+	pulp := newTestPulp()
+	legacy := legacyMsgCheckpointAck{
+		From:        types.HexToHeimdallAddress("0x0000000000000000000000000000000000000001"),
+		HeaderBlock: 10000,
+		TxHash:      types.HexToHeimdallHash("0x03"),
+		LogIndex:    2,
+	}
+	txBytes := legacyTxBytes(t, "checkpoint::checkpoint-ack", legacy)
+
+	decoded, err := pulp.DecodeBytes(txBytes)
+	require.NoError(t, err)
+	tx := decoded.(authTypes.StdTx)
+	msg := NewMsgCheckpointAck(legacy.From, legacy.HeaderBlock, legacy.TxHash, legacy.LogIndex, "")
+	require.Equal(t, msg, tx.Msg)
+
+	encoded, err := pulp.EncodeToBytes(tx)
+	require.NoError(t, err)
+	require.Equal(t, txBytes, encoded)
+
+	msg.ChainID = "15001"
+	encoded, err = pulp.EncodeToBytes(authTypes.NewStdTx(msg, tx.Signature, tx.Memo))
+	require.NoError(t, err)
+	decoded, err = pulp.DecodeBytes(encoded)
+	require.NoError(t, err)
+	require.Equal(t, msg, decoded.(authTypes.StdTx).Msg)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -12,11 +13,19 @@ import (
 // Default parameter values
 const (
 	DefaultCheckpointBufferTime time.Duration = 1000 * time.Second // Time checkpoint is allowed to stay in buffer (1000 seconds ~ 17 mins)
+	DefaultAvgCheckpointLength  uint64        = 256                // Average number of blocks checkpoint would contain
+	DefaultMaxCheckpointLength  uint64        = 1024               // Maximum number of blocks checkpoint would contain
+	DefaultChildBlockInterval   uint64        = 10000              // Difference between header index of 2 child blocks submitted on main chain
+	DefaultNoACKWaitTime        time.Duration = 1800 * time.Second // Time ack service waits to clear buffer and elect new proposer (1800 seconds ~ 30 mins)
 )
 
 // Parameter keys
 var (
 	KeyCheckpointBufferTime = []byte("CheckpointBufferTime")
+	KeyAvgCheckpointLength  = []byte("AvgCheckpointLength")
+	KeyMaxCheckpointLength  = []byte("MaxCheckpointLength")
+	KeyChildBlockInterval   = []byte("ChildBlockInterval")
+	KeyNoACKWaitTime        = []byte("NoACKWaitTime")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -24,14 +33,26 @@ var _ subspace.ParamSet = &Params{}
 // Params defines the parameters for the auth module.
 type Params struct {
 	CheckpointBufferTime time.Duration `json:"checkpoint_buffer_time" yaml:"checkpoint_buffer_time"`
+	AvgCheckpointLength  uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"`
+	MaxCheckpointLength  uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"`
+	ChildBlockInterval   uint64        `json:"child_chain_block_interval" yaml:"child_chain_block_interval"`
+	NoACKWaitTime        time.Duration `json:"no_ack_wait_time" yaml:"no_ack_wait_time"`
 }
 
 // NewParams creates a new Params object
 func NewParams(
 	checkpointBufferTime time.Duration,
+	avgCheckpointLength uint64,
+	maxCheckpointLength uint64,
+	childBlockInterval uint64,
+	noACKWaitTime time.Duration,
 ) Params {
 	return Params{
 		CheckpointBufferTime: checkpointBufferTime,
+		AvgCheckpointLength:  avgCheckpointLength,
+		MaxCheckpointLength:  maxCheckpointLength,
+		ChildBlockInterval:   childBlockInterval,
+		NoACKWaitTime:        noACKWaitTime,
 	}
 }
 
@@ -46,9 +67,20 @@ func ParamKeyTable() subspace.KeyTable {
 func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 	return subspace.ParamSetPairs{
 		{KeyCheckpointBufferTime, &p.CheckpointBufferTime},
+		{KeyAvgCheckpointLength, &p.AvgCheckpointLength},
+		{KeyMaxCheckpointLength, &p.MaxCheckpointLength},
+		{KeyChildBlockInterval, &p.ChildBlockInterval},
+		{KeyNoACKWaitTime, &p.NoACKWaitTime},
 	}
 }
 
+// ImmutableParamKeys implements the ImmutableParamSet interface. Checkpoint header
+// blocks on root chain are ack count * child block interval, so the interval is
+// only set at genesis.
+func (p *Params) ImmutableParamKeys() [][]byte {
+	return [][]byte{KeyChildBlockInterval}
+}
+
 // Equal returns a boolean determining if two Params types are identical.
 func (p Params) Equal(p2 Params) bool {
 	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
@@ -60,6 +92,10 @@ func (p Params) Equal(p2 Params) bool {
 func DefaultParams() Params {
 	return Params{
 		CheckpointBufferTime: DefaultCheckpointBufferTime,
+		AvgCheckpointLength:  DefaultAvgCheckpointLength,
+		MaxCheckpointLength:  DefaultMaxCheckpointLength,
+		ChildBlockInterval:   DefaultChildBlockInterval,
+		NoACKWaitTime:        DefaultNoACKWaitTime,
 	}
 }
 
@@ -68,10 +104,34 @@ func (p Params) String() string {
 	var sb strings.Builder
 	sb.WriteString("Params: \n")
 	sb.WriteString(fmt.Sprintf("CheckpointBufferTime: %s\n", p.CheckpointBufferTime))
+	sb.WriteString(fmt.Sprintf("AvgCheckpointLength: %d\n", p.AvgCheckpointLength))
+	sb.WriteString(fmt.Sprintf("MaxCheckpointLength: %d\n", p.MaxCheckpointLength))
+	sb.WriteString(fmt.Sprintf("ChildBlockInterval: %d\n", p.ChildBlockInterval))
+	sb.WriteString(fmt.Sprintf("NoACKWaitTime: %s\n", p.NoACKWaitTime))
 	return sb.String()
 }
 
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
+	if p.CheckpointBufferTime <= 0 {
+		return errors.New("checkpoint buffer time should be positive")
+	}
+
+	if p.AvgCheckpointLength == 0 {
+		return errors.New("avg checkpoint length should be positive")
+	}
+
+	if p.MaxCheckpointLength < p.AvgCheckpointLength {
+		return fmt.Errorf("max checkpoint length %d should not be less than avg checkpoint length %d", p.MaxCheckpointLength, p.AvgCheckpointLength)
+	}
+
+	if p.ChildBlockInterval == 0 {
+		return errors.New("child block interval should be positive")
+	}
+
+	if p.NoACKWaitTime <= 0 {
+		return errors.New("no-ack wait time should be positive")
+	}
+
 	return nil
 }
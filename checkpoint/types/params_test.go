@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	params := DefaultParams()
+	params.AvgCheckpointLength = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MaxCheckpointLength = params.AvgCheckpointLength - 1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.ChildBlockInterval = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.NoACKWaitTime = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.CheckpointBufferTime = 0
+	require.Error(t, params.Validate())
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"github.com/maticnetwork/bor/common/hexutil"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// BlockProof represents inclusion proof of bor block header in checkpoint.
+// Leaf is keccak256(blockNumber, blockTime, txRoot, receiptRoot), each padded to 32 bytes,
+// and proof is concatenation of sibling hashes from leaf to root.
+type BlockProof struct {
+	HeaderIndex uint64               `json:"headerIndex"`
+	StartBlock  uint64               `json:"startBlock"`
+	EndBlock    uint64               `json:"endBlock"`
+	RootHash    hmTypes.HeimdallHash `json:"rootHash"`
+	BlockNumber uint64               `json:"blockNumber"`
+	BlockTime   uint64               `json:"blockTime"`
+	TxRoot      hmTypes.HeimdallHash `json:"txRoot"`
+	ReceiptRoot hmTypes.HeimdallHash `json:"receiptRoot"`
+	Leaf        hmTypes.HeimdallHash `json:"leaf"`
+	LeafIndex   uint64               `json:"leafIndex"`
+	Proof       hexutil.Bytes        `json:"proof"`
+}
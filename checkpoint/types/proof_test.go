@@ -0,0 +1,86 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBlockProof(t *testing.T) {
+	var blockHeaders []*types.Header
+	for i := uint64(100); i <= 104; i++ {
+		blockHeaders = append(blockHeaders, &types.Header{
+			Number:      new(big.Int).SetUint64(i),
+			Time:        1000 + i,
+			TxHash:      common.BytesToHash([]byte{byte(i), 1}),
+			ReceiptHash: common.BytesToHash([]byte{byte(i), 2}),
+		})
+	}
+
+	tree, err := getHeaderTree(blockHeaders)
+	require.NoError(t, err)
+
+	for index, blockHeader := range blockHeaders {
+		proof := getBlockProof(tree, blockHeaders, uint64(index))
+		require.Equal(t, uint64(100), proof.StartBlock)
+		require.Equal(t, uint64(104), proof.EndBlock)
+		require.Equal(t, blockHeader.Number.Uint64(), proof.BlockNumber)
+		require.Equal(t, uint64(index), proof.LeafIndex)
+
+		// leaf is built from block fields like exit contracts do
+		leaf := crypto.Keccak256(appendBytes32(
+			new(big.Int).SetUint64(proof.BlockNumber).Bytes(),
+			new(big.Int).SetUint64(proof.BlockTime).Bytes(),
+			proof.TxRoot.Bytes(),
+			proof.ReceiptRoot.Bytes(),
+		))
+		require.Equal(t, leaf, proof.Leaf.Bytes())
+
+		// 5 headers are padded to 8 leaves
+		require.Len(t, proof.Proof, 3*32)
+
+		// walk up the tree same as rootchain merkle membership check
+		computed := leaf
+		leafIndex := proof.LeafIndex
+		for i := 0; i < len(proof.Proof); i += 32 {
+			sibling := proof.Proof[i : i+32]
+			if leafIndex%2 == 0 {
+				computed = crypto.Keccak256(computed, sibling)
+			} else {
+				computed = crypto.Keccak256(sibling, computed)
+			}
+			leafIndex = leafIndex / 2
+		}
+		require.Equal(t, proof.RootHash.Bytes(), computed)
+	}
+}
+
+func TestGetLeavesFromCache(t *testing.T) {
+	var blockHeaders []*types.Header
+	for i := uint64(200); i <= 202; i++ {
+		blockHeader := &types.Header{
+			Number:      new(big.Int).SetUint64(i),
+			Time:        1000 + i,
+			TxHash:      common.BytesToHash([]byte{byte(i), 1}),
+			ReceiptHash: common.BytesToHash([]byte{byte(i), 2}),
+		}
+		blockHeaders = append(blockHeaders, blockHeader)
+		leafCache.Add(leafCacheKey{"15001", i}, getHeaderLeaf(blockHeader))
+	}
+
+	// all leaves are cached, so no rpc call is made
+	leaves, err := getLeaves(nil, "15001", 200, 202)
+	require.NoError(t, err)
+	require.Len(t, leaves, 3)
+
+	leafTree, err := getLeafTree(leaves)
+	require.NoError(t, err)
+
+	headerTree, err := getHeaderTree(blockHeaders)
+	require.NoError(t, err)
+	require.Equal(t, headerTree.Root().Hash, leafTree.Root().Hash)
+}
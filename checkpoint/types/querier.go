@@ -1,5 +1,9 @@
 package types
 
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
 	QueryParams           = "params"
@@ -11,7 +15,15 @@ const (
 	QueryNextCheckpoint   = "next-checkpoint"
 	QueryProposer         = "is-proposer"
 	QueryCurrentProposer  = "current-proposer"
-	StakingQuerierRoute   = "staking"
+	QueryBlockProof       = "block-proof"
+
+	QueryCheckpointByBlock     = "checkpoint-by-block"
+	QueryCheckpointsByRange    = "checkpoints-by-range"
+	QueryCheckpointsByProposer = "checkpoints-by-proposer"
+	QueryValidatorStats        = "validator-stats"
+	QueryAllValidatorStats     = "all-validator-stats"
+	QueryCheckpointSignatures  = "checkpoint-signatures"
+	StakingQuerierRoute        = "staking"
 )
 
 // QueryCheckpointParams defines the params for querying accounts.
@@ -23,3 +35,59 @@ type QueryCheckpointParams struct {
 func NewQueryCheckpointParams(headerIndex uint64) QueryCheckpointParams {
 	return QueryCheckpointParams{HeaderIndex: headerIndex}
 }
+
+// QueryBlockParams defines the params for querying by bor block number.
+type QueryBlockParams struct {
+	BlockNumber uint64
+}
+
+// NewQueryBlockParams creates a new instance of QueryBlockParams.
+func NewQueryBlockParams(blockNumber uint64) QueryBlockParams {
+	return QueryBlockParams{BlockNumber: blockNumber}
+}
+
+// QueryBlockRangeParams defines the params for querying checkpoints by bor block range.
+type QueryBlockRangeParams struct {
+	StartBlock uint64
+	EndBlock   uint64
+	Page       uint64
+	Limit      uint64
+}
+
+// NewQueryBlockRangeParams creates a new instance of QueryBlockRangeParams.
+func NewQueryBlockRangeParams(startBlock uint64, endBlock uint64, page uint64, limit uint64) QueryBlockRangeParams {
+	return QueryBlockRangeParams{StartBlock: startBlock, EndBlock: endBlock, Page: page, Limit: limit}
+}
+
+// QueryProposerParams defines the params for querying checkpoints by proposer.
+type QueryProposerParams struct {
+	Proposer hmTypes.HeimdallAddress
+	Page     uint64
+	Limit    uint64
+}
+
+// NewQueryProposerParams creates a new instance of QueryProposerParams.
+func NewQueryProposerParams(proposer hmTypes.HeimdallAddress, page uint64, limit uint64) QueryProposerParams {
+	return QueryProposerParams{Proposer: proposer, Page: page, Limit: limit}
+}
+
+// QueryValidatorStatsParams defines the params for querying checkpoint stats of validator.
+type QueryValidatorStatsParams struct {
+	ValidatorID hmTypes.ValidatorID
+}
+
+// NewQueryValidatorStatsParams creates a new instance of QueryValidatorStatsParams.
+func NewQueryValidatorStatsParams(validatorID hmTypes.ValidatorID) QueryValidatorStatsParams {
+	return QueryValidatorStatsParams{ValidatorID: validatorID}
+}
+
+// IndexedCheckpoint represents checkpoint with its header index
+type IndexedCheckpoint struct {
+	HeaderIndex uint64 `json:"headerIndex"`
+	hmTypes.CheckpointBlockHeader
+}
+
+// NewIndexedCheckpoint creates a new instance of IndexedCheckpoint.
+func NewIndexedCheckpoint(headerIndex uint64, checkpoint hmTypes.CheckpointBlockHeader) IndexedCheckpoint {
+	return IndexedCheckpoint{HeaderIndex: headerIndex, CheckpointBlockHeader: checkpoint}
+}
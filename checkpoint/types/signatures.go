@@ -0,0 +1,41 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointSigner represents validator's precommit on the block with checkpoint
+type CheckpointSigner struct {
+	ValidatorID hmTypes.ValidatorID     `json:"ID" yaml:"ID"`
+	Signer      hmTypes.HeimdallAddress `json:"signer" yaml:"signer"`
+	VotingPower int64                   `json:"power" yaml:"power"`
+	Signed      bool                    `json:"signed" yaml:"signed"`
+}
+
+// CheckpointSignatures represents validator signatures submitted to rootchain with checkpoint
+type CheckpointSignatures struct {
+	HeaderIndex  uint64             `json:"headerIndex" yaml:"headerIndex"`
+	Height       int64              `json:"height" yaml:"height"` // heimdall block which included checkpoint
+	Signers      []CheckpointSigner `json:"signers" yaml:"signers"`
+	SignedPower  int64              `json:"signed_power" yaml:"signed_power"`
+	TotalPower   int64              `json:"total_power" yaml:"total_power"`
+	ThresholdMet bool               `json:"threshold_met" yaml:"threshold_met"` // signed power is more than 2/3 of total power
+}
+
+// NewCheckpointSignatures creates signatures for checkpoint included at height
+func NewCheckpointSignatures(height int64, signers []CheckpointSigner) CheckpointSignatures {
+	sigs := CheckpointSignatures{
+		Height:  height,
+		Signers: signers,
+	}
+
+	for _, signer := range signers {
+		sigs.TotalPower += signer.VotingPower
+		if signer.Signed {
+			sigs.SignedPower += signer.VotingPower
+		}
+	}
+	sigs.ThresholdMet = sigs.SignedPower*3 > sigs.TotalPower*2
+
+	return sigs
+}
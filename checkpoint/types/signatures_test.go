@@ -0,0 +1,26 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCheckpointSignatures(t *testing.T) {
+	signers := []CheckpointSigner{
+		{ValidatorID: 1, VotingPower: 10, Signed: true},
+		{ValidatorID: 2, VotingPower: 10, Signed: true},
+		{ValidatorID: 3, VotingPower: 10, Signed: false},
+	}
+
+	// 2/3 of power is not enough
+	sigs := NewCheckpointSignatures(10, signers)
+	require.Equal(t, int64(20), sigs.SignedPower)
+	require.Equal(t, int64(30), sigs.TotalPower)
+	require.False(t, sigs.ThresholdMet)
+
+	signers[2].Signed = true
+	sigs = NewCheckpointSignatures(10, signers)
+	require.Equal(t, int64(30), sigs.SignedPower)
+	require.True(t, sigs.ThresholdMet)
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ValidatorStats represents checkpoint accountability counters of validator
+type ValidatorStats struct {
+	ValidatorID hmTypes.ValidatorID `json:"ID" yaml:"ID"`
+	Proposed    uint64              `json:"proposed" yaml:"proposed"` // checkpoints proposed and added to buffer
+	Acked       uint64              `json:"acked" yaml:"acked"`       // checkpoints submitted on rootchain and acknowledged
+	NoAcks      uint64              `json:"no_acks" yaml:"no_acks"`   // proposer turns missed, which were skipped by no-ack
+	Expired     uint64              `json:"expired" yaml:"expired"`   // proposed checkpoints which expired in buffer without ack
+}
+
+// NewValidatorStats creates empty stats for validator
+func NewValidatorStats(validatorID hmTypes.ValidatorID) ValidatorStats {
+	return ValidatorStats{
+		ValidatorID: validatorID,
+	}
+}
+
+// String returns human readable stats
+func (s ValidatorStats) String() string {
+	return fmt.Sprintf("ValidatorStats{%v proposed %v acked %v noAcks %v expired %v}", s.ValidatorID, s.Proposed, s.Acked, s.NoAcks, s.Expired)
+}
@@ -43,6 +43,7 @@ func handleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, c
 	}
 
 	// get confirmed tx receipt
+	// TODO move to side-tx handler, receipt is fetched in DeliverTx (see sidetx package)
 	receipt, err := contractCaller.GetConfirmedTxReceipt(ctx.BlockTime(), msg.TxHash.EthHash(), params.TxConfirmationTime)
 	if receipt == nil || err != nil {
 		return common.ErrWaitForConfirmation(k.Codespace(), params.TxConfirmationTime).Result()
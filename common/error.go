@@ -28,6 +28,8 @@ const (
 	CodeOldCheckpoint            CodeType = 1509
 	CodeDisCountinuousCheckpoint CodeType = 1510
 	CodeNoCheckpointBuffer       CodeType = 1511
+	CodeCheckpointTooLong        CodeType = 1512
+	CodeInvalidCheckpointChain   CodeType = 1513
 
 	CodeOldValidator       CodeType = 2500
 	CodeNoValidator        CodeType = 2501
@@ -82,6 +84,14 @@ func ErrDisCountinuousCheckpoint(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeDisCountinuousCheckpoint, "Checkpoint not in countinuity")
 }
 
+func ErrCheckpointTooLong(codespace sdk.CodespaceType, length uint64, maxLength uint64) sdk.Error {
+	return newError(codespace, CodeCheckpointTooLong, fmt.Sprintf("Checkpoint length %v exceeds max checkpoint length %v", length, maxLength))
+}
+
+func ErrInvalidCheckpointChain(codespace sdk.CodespaceType, chainID string) sdk.Error {
+	return newError(codespace, CodeInvalidCheckpointChain, fmt.Sprintf("Unknown checkpoint chain %v", chainID))
+}
+
 func ErrNoACK(codespace sdk.CodespaceType, expiresAt uint64) sdk.Error {
 	return newError(codespace, CodeNoACK, fmt.Sprintf("Checkpoint Already Exists In Buffer, ACK expected, expires at %s", strconv.FormatUint(expiresAt, 10)))
 }
@@ -5,7 +5,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/maticnetwork/bor/rpc"
 	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
 )
 
 //  Test - to check heimdall config
@@ -25,3 +27,38 @@ func TestHeimdallConfig(t *testing.T) {
 	fmt.Println("PublicKey", pubKey.String())
 	// fmt.Println("CryptoPublicKey", pubKey.CryptoPubKey().String())
 }
+
+func TestGetBorRPCClient(t *testing.T) {
+	matic := rpc.DialInProc(rpc.NewServer())
+	defer matic.Close()
+	chain := rpc.DialInProc(rpc.NewServer())
+	defer chain.Close()
+
+	prevMatic, prevClients := maticRPCClient, borRPCClients
+	defer func() { maticRPCClient, borRPCClients = prevMatic, prevClients }()
+	maticRPCClient = matic
+	borRPCClients = map[string]*rpc.Client{"15002": chain}
+
+	// default chain uses matic's client
+	client, err := GetBorRPCClient("")
+	require.NoError(t, err)
+	require.Equal(t, matic, client)
+
+	// checkpoint chain uses configured client
+	client, err = GetBorRPCClient("15002")
+	require.NoError(t, err)
+	require.Equal(t, chain, client)
+
+	// checkpoint chain without endpoint never falls back to matic's client
+	client, err = GetBorRPCClient("15003")
+	require.Error(t, err)
+	require.Nil(t, client)
+
+	ethClient, err := GetBorClient("15003")
+	require.Error(t, err)
+	require.Nil(t, ethClient)
+
+	ethClient, err = GetBorClient("15002")
+	require.NoError(t, err)
+	require.NotNil(t, ethClient)
+}
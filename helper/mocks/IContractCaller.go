@@ -6,17 +6,27 @@ import (
 	big "math/big"
 
 	common "github.com/maticnetwork/bor/common"
+	erc20 "github.com/maticnetwork/heimdall/contracts/erc20"
+
 	heimdalltypes "github.com/maticnetwork/heimdall/types"
 
 	mock "github.com/stretchr/testify/mock"
 
 	rootchain "github.com/maticnetwork/heimdall/contracts/rootchain"
 
+	stakemanager "github.com/maticnetwork/heimdall/contracts/stakemanager"
+
 	stakinginfo "github.com/maticnetwork/heimdall/contracts/stakinginfo"
 
+	statereceiver "github.com/maticnetwork/heimdall/contracts/statereceiver"
+
 	statesender "github.com/maticnetwork/heimdall/contracts/statesender"
 
+	time "time"
+
 	types "github.com/maticnetwork/bor/core/types"
+
+	validatorset "github.com/maticnetwork/heimdall/contracts/validatorset"
 )
 
 // IContractCaller is an autogenerated mock type for the IContractCaller type
@@ -24,13 +34,13 @@ type IContractCaller struct {
 	mock.Mock
 }
 
-// ApproveTokens provides a mock function with given fields: _a0
-func (_m *IContractCaller) ApproveTokens(_a0 *big.Int) error {
-	ret := _m.Called(_a0)
+// ApproveTokens provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IContractCaller) ApproveTokens(_a0 *big.Int, _a1 common.Address, _a2 common.Address, _a3 *erc20.Erc20) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(*big.Int, common.Address, common.Address, *erc20.Erc20) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -38,22 +48,20 @@ func (_m *IContractCaller) ApproveTokens(_a0 *big.Int) error {
 	return r0
 }
 
-// CurrentAccountStateRoot provides a mock function with given fields:
-func (_m *IContractCaller) CurrentAccountStateRoot() ([32]byte, error) {
-	ret := _m.Called()
+// CurrentAccountStateRoot provides a mock function with given fields: stakingInfoInstance
+func (_m *IContractCaller) CurrentAccountStateRoot(stakingInfoInstance *stakinginfo.Stakinginfo) ([32]byte, error) {
+	ret := _m.Called(stakingInfoInstance)
 
 	var r0 [32]byte
-	if rf, ok := ret.Get(0).(func() [32]byte); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*stakinginfo.Stakinginfo) [32]byte); ok {
+		r0 = rf(stakingInfoInstance)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([32]byte)
-		}
+		r0 = ret.Get(0).([32]byte)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*stakinginfo.Stakinginfo) error); ok {
+		r1 = rf(stakingInfoInstance)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,20 +69,20 @@ func (_m *IContractCaller) CurrentAccountStateRoot() ([32]byte, error) {
 	return r0, r1
 }
 
-// CurrentHeaderBlock provides a mock function with given fields:
-func (_m *IContractCaller) CurrentHeaderBlock() (uint64, error) {
-	ret := _m.Called()
+// CurrentHeaderBlock provides a mock function with given fields: rootChainInstance
+func (_m *IContractCaller) CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain) (uint64, error) {
+	ret := _m.Called(rootChainInstance)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*rootchain.Rootchain) uint64); ok {
+		r0 = rf(rootChainInstance)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*rootchain.Rootchain) error); ok {
+		r1 = rf(rootChainInstance)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -82,13 +90,13 @@ func (_m *IContractCaller) CurrentHeaderBlock() (uint64, error) {
 	return r0, r1
 }
 
-// CurrentSpanNumber provides a mock function with given fields:
-func (_m *IContractCaller) CurrentSpanNumber() *big.Int {
-	ret := _m.Called()
+// CurrentSpanNumber provides a mock function with given fields: _a0
+func (_m *IContractCaller) CurrentSpanNumber(_a0 *validatorset.Validatorset) *big.Int {
+	ret := _m.Called(_a0)
 
 	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*validatorset.Validatorset) *big.Int); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*big.Int)
@@ -98,13 +106,13 @@ func (_m *IContractCaller) CurrentSpanNumber() *big.Int {
 	return r0
 }
 
-// CurrentStateCounter provides a mock function with given fields:
-func (_m *IContractCaller) CurrentStateCounter() *big.Int {
-	ret := _m.Called()
+// CurrentStateCounter provides a mock function with given fields: stateSenderInstance
+func (_m *IContractCaller) CurrentStateCounter(stateSenderInstance *statesender.Statesender) *big.Int {
+	ret := _m.Called(stateSenderInstance)
 
 	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*statesender.Statesender) *big.Int); ok {
+		r0 = rf(stateSenderInstance)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*big.Int)
@@ -114,13 +122,13 @@ func (_m *IContractCaller) CurrentStateCounter() *big.Int {
 	return r0
 }
 
-// DecodeNewHeaderBlockEvent provides a mock function with given fields: _a0, _a1
-func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 *types.Receipt, _a1 uint64) (*rootchain.RootchainNewHeaderBlock, error) {
-	ret := _m.Called(_a0, _a1)
+// DecodeNewHeaderBlockEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*rootchain.RootchainNewHeaderBlock, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 *rootchain.RootchainNewHeaderBlock
-	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *rootchain.RootchainNewHeaderBlock); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *rootchain.RootchainNewHeaderBlock); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*rootchain.RootchainNewHeaderBlock)
@@ -128,8 +136,8 @@ func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 *types.Receipt, _a1 uin
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -137,13 +145,13 @@ func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 *types.Receipt, _a1 uin
 	return r0, r1
 }
 
-// DecodeSignerUpdateEvent provides a mock function with given fields: _a0, _a1
-func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoSignerChange, error) {
-	ret := _m.Called(_a0, _a1)
+// DecodeSignerUpdateEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoSignerChange, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 *stakinginfo.StakinginfoSignerChange
-	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoSignerChange); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoSignerChange); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*stakinginfo.StakinginfoSignerChange)
@@ -151,8 +159,8 @@ func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 *types.Receipt, _a1 uint6
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -160,22 +168,22 @@ func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 *types.Receipt, _a1 uint6
 	return r0, r1
 }
 
-// DecodeValidatorStakeUpdateEvent provides a mock function with given fields: _a0, _a1
-func (_m *IContractCaller) DecodeValidatorStakeUpdateEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoStakeUpdate, error) {
-	ret := _m.Called(_a0, _a1)
+// DecodeStateSyncedEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeStateSyncedEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*statesender.StatesenderStateSynced, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
-	var r0 *stakinginfo.StakinginfoStakeUpdate
-	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoStakeUpdate); ok {
-		r0 = rf(_a0, _a1)
+	var r0 *statesender.StatesenderStateSynced
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *statesender.StatesenderStateSynced); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*stakinginfo.StakinginfoStakeUpdate)
+			r0 = ret.Get(0).(*statesender.StatesenderStateSynced)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -183,22 +191,22 @@ func (_m *IContractCaller) DecodeValidatorStakeUpdateEvent(_a0 *types.Receipt, _
 	return r0, r1
 }
 
-// DecodeValidatorTopupFeesEvent provides a mock function with given fields: _a0, _a1
-func (_m *IContractCaller) DecodeValidatorTopupFeesEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoTopUpFee, error) {
-	ret := _m.Called(_a0, _a1)
+// DecodeValidatorExitEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeValidatorExitEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoUnstakeInit, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
-	var r0 *stakinginfo.StakinginfoTopUpFee
-	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoTopUpFee); ok {
-		r0 = rf(_a0, _a1)
+	var r0 *stakinginfo.StakinginfoUnstakeInit
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoUnstakeInit); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*stakinginfo.StakinginfoTopUpFee)
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoUnstakeInit)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -206,22 +214,68 @@ func (_m *IContractCaller) DecodeValidatorTopupFeesEvent(_a0 *types.Receipt, _a1
 	return r0, r1
 }
 
-// EncodeStateSyncedEvent provides a mock function with given fields: _a0
-func (_m *IContractCaller) EncodeStateSyncedEvent(_a0 *types.Log) (*statesender.StatesenderStateSynced, error) {
-	ret := _m.Called(_a0)
+// DecodeValidatorJoinEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeValidatorJoinEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoStaked, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
-	var r0 *statesender.StatesenderStateSynced
-	if rf, ok := ret.Get(0).(func(*types.Log) *statesender.StatesenderStateSynced); ok {
-		r0 = rf(_a0)
+	var r0 *stakinginfo.StakinginfoStaked
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoStaked); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*statesender.StatesenderStateSynced)
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoStaked)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*types.Log) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeValidatorStakeUpdateEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeValidatorStakeUpdateEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoStakeUpdate, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoStakeUpdate
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoStakeUpdate); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoStakeUpdate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeValidatorTopupFeesEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeValidatorTopupFeesEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoTopUpFee, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoTopUpFee
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoTopUpFee); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoTopUpFee)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -316,13 +370,13 @@ func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte
 	return r0, r1, r2, r3
 }
 
-// GetConfirmedTxReceipt provides a mock function with given fields: _a0
-func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash) (*types.Receipt, error) {
-	ret := _m.Called(_a0)
+// GetConfirmedTxReceipt provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 time.Time, _a1 common.Hash, _a2 time.Duration) (*types.Receipt, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 *types.Receipt
-	if rf, ok := ret.Get(0).(func(common.Hash) *types.Receipt); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(time.Time, common.Hash, time.Duration) *types.Receipt); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Receipt)
@@ -330,8 +384,8 @@ func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash) (*types.Receip
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(common.Hash) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(time.Time, common.Hash, time.Duration) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -339,52 +393,48 @@ func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash) (*types.Receip
 	return r0, r1
 }
 
-// GetHeaderInfo provides a mock function with given fields: headerID
-func (_m *IContractCaller) GetHeaderInfo(headerID uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
-	ret := _m.Called(headerID)
+// GetHeaderInfo provides a mock function with given fields: headerID, rootChainInstance
+func (_m *IContractCaller) GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
+	ret := _m.Called(headerID, rootChainInstance)
 
 	var r0 common.Hash
-	if rf, ok := ret.Get(0).(func(uint64) common.Hash); ok {
-		r0 = rf(headerID)
+	if rf, ok := ret.Get(0).(func(uint64, *rootchain.Rootchain) common.Hash); ok {
+		r0 = rf(headerID, rootChainInstance)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(common.Hash)
-		}
+		r0 = ret.Get(0).(common.Hash)
 	}
 
 	var r1 uint64
-	if rf, ok := ret.Get(1).(func(uint64) uint64); ok {
-		r1 = rf(headerID)
+	if rf, ok := ret.Get(1).(func(uint64, *rootchain.Rootchain) uint64); ok {
+		r1 = rf(headerID, rootChainInstance)
 	} else {
 		r1 = ret.Get(1).(uint64)
 	}
 
 	var r2 uint64
-	if rf, ok := ret.Get(2).(func(uint64) uint64); ok {
-		r2 = rf(headerID)
+	if rf, ok := ret.Get(2).(func(uint64, *rootchain.Rootchain) uint64); ok {
+		r2 = rf(headerID, rootChainInstance)
 	} else {
 		r2 = ret.Get(2).(uint64)
 	}
 
 	var r3 uint64
-	if rf, ok := ret.Get(3).(func(uint64) uint64); ok {
-		r3 = rf(headerID)
+	if rf, ok := ret.Get(3).(func(uint64, *rootchain.Rootchain) uint64); ok {
+		r3 = rf(headerID, rootChainInstance)
 	} else {
 		r3 = ret.Get(3).(uint64)
 	}
 
 	var r4 heimdalltypes.HeimdallAddress
-	if rf, ok := ret.Get(4).(func(uint64) heimdalltypes.HeimdallAddress); ok {
-		r4 = rf(headerID)
+	if rf, ok := ret.Get(4).(func(uint64, *rootchain.Rootchain) heimdalltypes.HeimdallAddress); ok {
+		r4 = rf(headerID, rootChainInstance)
 	} else {
-		if ret.Get(4) != nil {
-			r4 = ret.Get(4).(heimdalltypes.HeimdallAddress)
-		}
+		r4 = ret.Get(4).(heimdalltypes.HeimdallAddress)
 	}
 
 	var r5 error
-	if rf, ok := ret.Get(5).(func(uint64) error); ok {
-		r5 = rf(headerID)
+	if rf, ok := ret.Get(5).(func(uint64, *rootchain.Rootchain) error); ok {
+		r5 = rf(headerID, rootChainInstance)
 	} else {
 		r5 = ret.Error(5)
 	}
@@ -392,20 +442,20 @@ func (_m *IContractCaller) GetHeaderInfo(headerID uint64) (common.Hash, uint64,
 	return r0, r1, r2, r3, r4, r5
 }
 
-// GetLastChildBlock provides a mock function with given fields:
-func (_m *IContractCaller) GetLastChildBlock() (uint64, error) {
-	ret := _m.Called()
+// GetLastChildBlock provides a mock function with given fields: rootChainInstance
+func (_m *IContractCaller) GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error) {
+	ret := _m.Called(rootChainInstance)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(*rootchain.Rootchain) uint64); ok {
+		r0 = rf(rootChainInstance)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*rootchain.Rootchain) error); ok {
+		r1 = rf(rootChainInstance)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -482,6 +532,29 @@ func (_m *IContractCaller) GetMaticChainBlock(_a0 *big.Int) (*types.Header, erro
 	return r0, r1
 }
 
+// GetMaticTokenInstance provides a mock function with given fields: maticTokenAddress
+func (_m *IContractCaller) GetMaticTokenInstance(maticTokenAddress common.Address) (*erc20.Erc20, error) {
+	ret := _m.Called(maticTokenAddress)
+
+	var r0 *erc20.Erc20
+	if rf, ok := ret.Get(0).(func(common.Address) *erc20.Erc20); ok {
+		r0 = rf(maticTokenAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*erc20.Erc20)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(maticTokenAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMaticTxReceipt provides a mock function with given fields: _a0
 func (_m *IContractCaller) GetMaticTxReceipt(_a0 common.Hash) (*types.Receipt, error) {
 	ret := _m.Called(_a0)
@@ -505,13 +578,36 @@ func (_m *IContractCaller) GetMaticTxReceipt(_a0 common.Hash) (*types.Receipt, e
 	return r0, r1
 }
 
-// GetSpanDetails provides a mock function with given fields: id
-func (_m *IContractCaller) GetSpanDetails(id *big.Int) (*big.Int, *big.Int, *big.Int, error) {
-	ret := _m.Called(id)
+// GetRootChainInstance provides a mock function with given fields: rootchainAddress
+func (_m *IContractCaller) GetRootChainInstance(rootchainAddress common.Address) (*rootchain.Rootchain, error) {
+	ret := _m.Called(rootchainAddress)
+
+	var r0 *rootchain.Rootchain
+	if rf, ok := ret.Get(0).(func(common.Address) *rootchain.Rootchain); ok {
+		r0 = rf(rootchainAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootchain.Rootchain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(rootchainAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSpanDetails provides a mock function with given fields: id, _a1
+func (_m *IContractCaller) GetSpanDetails(id *big.Int, _a1 *validatorset.Validatorset) (*big.Int, *big.Int, *big.Int, error) {
+	ret := _m.Called(id, _a1)
 
 	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func(*big.Int) *big.Int); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(*big.Int, *validatorset.Validatorset) *big.Int); ok {
+		r0 = rf(id, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*big.Int)
@@ -519,8 +615,8 @@ func (_m *IContractCaller) GetSpanDetails(id *big.Int) (*big.Int, *big.Int, *big
 	}
 
 	var r1 *big.Int
-	if rf, ok := ret.Get(1).(func(*big.Int) *big.Int); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(*big.Int, *validatorset.Validatorset) *big.Int); ok {
+		r1 = rf(id, _a1)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*big.Int)
@@ -528,8 +624,8 @@ func (_m *IContractCaller) GetSpanDetails(id *big.Int) (*big.Int, *big.Int, *big
 	}
 
 	var r2 *big.Int
-	if rf, ok := ret.Get(2).(func(*big.Int) *big.Int); ok {
-		r2 = rf(id)
+	if rf, ok := ret.Get(2).(func(*big.Int, *validatorset.Validatorset) *big.Int); ok {
+		r2 = rf(id, _a1)
 	} else {
 		if ret.Get(2) != nil {
 			r2 = ret.Get(2).(*big.Int)
@@ -537,8 +633,8 @@ func (_m *IContractCaller) GetSpanDetails(id *big.Int) (*big.Int, *big.Int, *big
 	}
 
 	var r3 error
-	if rf, ok := ret.Get(3).(func(*big.Int) error); ok {
-		r3 = rf(id)
+	if rf, ok := ret.Get(3).(func(*big.Int, *validatorset.Validatorset) error); ok {
+		r3 = rf(id, _a1)
 	} else {
 		r3 = ret.Error(3)
 	}
@@ -546,20 +642,112 @@ func (_m *IContractCaller) GetSpanDetails(id *big.Int) (*big.Int, *big.Int, *big
 	return r0, r1, r2, r3
 }
 
-// GetValidatorInfo provides a mock function with given fields: valID
-func (_m *IContractCaller) GetValidatorInfo(valID heimdalltypes.ValidatorID) (heimdalltypes.Validator, error) {
-	ret := _m.Called(valID)
+// GetStakeManagerInstance provides a mock function with given fields: stakingManagerAddress
+func (_m *IContractCaller) GetStakeManagerInstance(stakingManagerAddress common.Address) (*stakemanager.Stakemanager, error) {
+	ret := _m.Called(stakingManagerAddress)
+
+	var r0 *stakemanager.Stakemanager
+	if rf, ok := ret.Get(0).(func(common.Address) *stakemanager.Stakemanager); ok {
+		r0 = rf(stakingManagerAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakemanager.Stakemanager)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(stakingManagerAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStakingInfoInstance provides a mock function with given fields: stakingInfoAddress
+func (_m *IContractCaller) GetStakingInfoInstance(stakingInfoAddress common.Address) (*stakinginfo.Stakinginfo, error) {
+	ret := _m.Called(stakingInfoAddress)
+
+	var r0 *stakinginfo.Stakinginfo
+	if rf, ok := ret.Get(0).(func(common.Address) *stakinginfo.Stakinginfo); ok {
+		r0 = rf(stakingInfoAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.Stakinginfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(stakingInfoAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStateReceiverInstance provides a mock function with given fields: stateReceiverAddress
+func (_m *IContractCaller) GetStateReceiverInstance(stateReceiverAddress common.Address) (*statereceiver.Statereceiver, error) {
+	ret := _m.Called(stateReceiverAddress)
+
+	var r0 *statereceiver.Statereceiver
+	if rf, ok := ret.Get(0).(func(common.Address) *statereceiver.Statereceiver); ok {
+		r0 = rf(stateReceiverAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statereceiver.Statereceiver)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(stateReceiverAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStateSenderInstance provides a mock function with given fields: stateSenderAddress
+func (_m *IContractCaller) GetStateSenderInstance(stateSenderAddress common.Address) (*statesender.Statesender, error) {
+	ret := _m.Called(stateSenderAddress)
+
+	var r0 *statesender.Statesender
+	if rf, ok := ret.Get(0).(func(common.Address) *statesender.Statesender); ok {
+		r0 = rf(stateSenderAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statesender.Statesender)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(stateSenderAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetValidatorInfo provides a mock function with given fields: valID, stakingInfoInstance
+func (_m *IContractCaller) GetValidatorInfo(valID heimdalltypes.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (heimdalltypes.Validator, error) {
+	ret := _m.Called(valID, stakingInfoInstance)
 
 	var r0 heimdalltypes.Validator
-	if rf, ok := ret.Get(0).(func(heimdalltypes.ValidatorID) heimdalltypes.Validator); ok {
-		r0 = rf(valID)
+	if rf, ok := ret.Get(0).(func(heimdalltypes.ValidatorID, *stakinginfo.Stakinginfo) heimdalltypes.Validator); ok {
+		r0 = rf(valID, stakingInfoInstance)
 	} else {
 		r0 = ret.Get(0).(heimdalltypes.Validator)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(heimdalltypes.ValidatorID) error); ok {
-		r1 = rf(valID)
+	if rf, ok := ret.Get(1).(func(heimdalltypes.ValidatorID, *stakinginfo.Stakinginfo) error); ok {
+		r1 = rf(valID, stakingInfoInstance)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -567,13 +755,36 @@ func (_m *IContractCaller) GetValidatorInfo(valID heimdalltypes.ValidatorID) (he
 	return r0, r1
 }
 
-// IsTxConfirmed provides a mock function with given fields: _a0
-func (_m *IContractCaller) IsTxConfirmed(_a0 common.Hash) bool {
-	ret := _m.Called(_a0)
+// GetValidatorSetInstance provides a mock function with given fields: validatorSetAddress
+func (_m *IContractCaller) GetValidatorSetInstance(validatorSetAddress common.Address) (*validatorset.Validatorset, error) {
+	ret := _m.Called(validatorSetAddress)
+
+	var r0 *validatorset.Validatorset
+	if rf, ok := ret.Get(0).(func(common.Address) *validatorset.Validatorset); ok {
+		r0 = rf(validatorSetAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*validatorset.Validatorset)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(validatorSetAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsTxConfirmed provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) IsTxConfirmed(_a0 time.Time, _a1 common.Hash, _a2 time.Duration) bool {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(common.Hash) bool); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(time.Time, common.Hash, time.Duration) bool); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
@@ -581,18 +792,13 @@ func (_m *IContractCaller) IsTxConfirmed(_a0 common.Hash) bool {
 	return r0
 }
 
-// SendCheckpoint provides a mock function with given fields: voteSignBytes, sigs, txData
-func (_m *IContractCaller) SendCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte) {
-	_m.Called(voteSignBytes, sigs, txData)
-}
-
-// StakeFor provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *IContractCaller) StakeFor(_a0 common.Address, _a1 *big.Int, _a2 *big.Int, _a3 bool) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3)
+// StakeFor provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *IContractCaller) StakeFor(_a0 common.Address, _a1 *big.Int, _a2 *big.Int, _a3 bool, _a4 common.Address, _a5 *stakemanager.Stakemanager) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, *big.Int, bool) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, *big.Int, bool, common.Address, *stakemanager.Stakemanager) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -25,18 +25,23 @@ bor_RPC_URL = "{{ .BorRPCUrl }}"
 # RPC endpoint for tendermint
 tendermint_RPC_URL = "{{ .TendermintRPCUrl }}"
 
+# RPC endpoints for additional checkpoint chains - comma separated <bor chain id>=<url>
+checkpoint_chain_RPC_URLs = "{{ .CheckpointChainRPCUrls }}"
+
 
 ##### MQTT and Rest Server Config #####
 
 # MQTT endpoint
 amqp_url = "{{ .AmqpURL }}"
 
+# Bridge queue backend - "amqp" (uses amqp_url) or "leveldb" (embedded, stored in bridge db)
+queue_backend = "{{ .QueueBackend }}"
+
 # Heimdall REST server endpoint
 heimdall_rest_server = "{{ .HeimdallServerURL }}"
 
 
 ##### Intervals #####
-child_chain_block_interval = "{{ .ChildBlockInterval }}"
 
 ## Bridge Poll Intervals
 checkpoint_poll_interval = "{{ .CheckpointerPollInterval }}"
@@ -44,17 +49,35 @@ syncer_poll_interval = "{{ .SyncerPollInterval }}"
 noack_poll_interval = "{{ .NoACKPollInterval }}"
 clerk_polling_interval = "{{ .ClerkPollingInterval }}"
 span_polling_interval = "{{ .SpanPollingInterval }}"
+side_tx_polling_interval = "{{ .SideTxPollingInterval }}"
+
+## Compare heimdall validators with staking contracts ("0s" disables), and optionally
+## send staking msgs replaying events missed by heimdall
+staking_reconcile_interval = "{{ .StakingReconcileInterval }}"
+staking_reconcile_fix = "{{ .StakingReconcileFix }}"
+## Rootchain block to search missed staking events from, later runs start from last block without diffs
+staking_reconcile_start_block = "{{ .StakingReconcileStartBlock }}"
+
+## Heimdall listener mode - "polling" (tx search) or "subscription" (tx search on new block headers over websocket, falls back to polling)
+heimdall_listener_mode = "{{ .HeimdallListenerMode }}"
+
+## Batch heimdall msgs sent by bridge - max msgs per tx (1 disables batching) and wait window.
+## A failing msg reverts the whole batch, so keep disabled unless event backlog needs it.
+heimdall_batch_size = "{{ .HeimdallBatchSize }}"
+heimdall_batch_window = "{{ .HeimdallBatchWindow }}"
 
 #### gas limits ####
 main_chain_gas_limit = "{{ .MainchainGasLimit }}"
 
-##### Checkpoint Length Config #####
-avg_checkpoint_length = "{{ .AvgCheckpointLength }}"
-max_checkpoint_length = "{{ .MaxCheckpointLength }}"
+#### gas price cap (in wei) ####
+main_chain_max_gas_price = "{{ .MainchainMaxGasPrice }}"
 
-##### Timeout Config #####
+#### confirmations ####
+main_chain_confirmations = "{{ .MainchainConfirmations }}"
 
-no_ack_wait_time = "{{ .NoACKWaitTime }}"
+#### stuck transactions ####
+tx_resubmit_timeout = "{{ .TxResubmitTimeout }}"
+tx_gas_bump_percent = "{{ .TxGasBumpPercent }}"
 
 `
 
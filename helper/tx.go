@@ -12,7 +12,6 @@ import (
 	"github.com/maticnetwork/bor/ethclient"
 	"github.com/maticnetwork/bor/rlp"
 	"github.com/maticnetwork/heimdall/contracts/erc20"
-	"github.com/maticnetwork/heimdall/contracts/rootchain"
 	"github.com/maticnetwork/heimdall/contracts/stakemanager"
 	"github.com/tendermint/tendermint/types"
 )
@@ -59,42 +58,22 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 	return
 }
 
-// SendCheckpoint sends checkpoint to rootchain contract
-// todo return err
-func (c *ContractCaller) SendCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte, rootChainAddress common.Address, rootChainInstance *rootchain.Rootchain) (er error) {
+// PackCheckpoint validates vote and packs submitHeaderBlock call data for rootchain contract
+func (c *ContractCaller) PackCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte) ([]byte, error) {
 	var vote types.CanonicalRLPVote
 	err := rlp.DecodeBytes(voteSignBytes, &vote)
 	if err != nil {
 		Logger.Error("Unable to decode vote while sending checkpoint", "vote", hex.EncodeToString(voteSignBytes), "sigs", hex.EncodeToString(sigs), "txData", hex.EncodeToString(txData))
-		return err
+		return nil, err
 	}
 
 	data, err := c.RootChainABI.Pack("submitHeaderBlock", voteSignBytes, sigs, txData)
 	if err != nil {
 		Logger.Error("Unable to pack tx for submitHeaderBlock", "error", err)
-		return err
-	}
-
-	auth, err := GenerateAuthObj(GetMainClient(), rootChainAddress, data)
-	if err != nil {
-		Logger.Error("Unable to create auth object", "error", err)
-		Logger.Info("Setting custom gaslimit", "gaslimit", GetConfig().MainchainGasLimit)
-		auth.GasLimit = GetConfig().MainchainGasLimit
+		return nil, err
 	}
-	GetPubKey().VerifyBytes(voteSignBytes, sigs)
 
-	Logger.Debug("Sending new checkpoint",
-		"vote", hex.EncodeToString(voteSignBytes),
-		"sigs", hex.EncodeToString(sigs),
-		"txData", hex.EncodeToString(txData))
-
-	tx, err := rootChainInstance.SubmitHeaderBlock(auth, voteSignBytes, sigs, txData)
-	if err != nil {
-		Logger.Error("Error while submitting checkpoint", "error", err)
-		return err
-	}
-	Logger.Info("Submitted new checkpoint to rootchain successfully", "txHash", tx.Hash().String())
-	return
+	return data, nil
 }
 
 // StakeFor stakes for a validator
@@ -23,20 +23,48 @@ func NewParamChangeProposalHandler(k Keeper) govtypes.Handler {
 }
 
 func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p types.ParameterChangeProposal) sdk.Error {
+	// apply all changes before validation, so that dependent params can be changed together
+	cacheCtx, writeCache := ctx.CacheContext()
+	var changed []string
+
 	for _, c := range p.Changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
 			return types.ErrUnknownSubspace(k.codespace, c.Subspace)
 		}
 
+		if ss.IsImmutable([]byte(c.Key)) {
+			return types.ErrImmutableParameter(k.codespace, c.Subspace, c.Key)
+		}
+
 		k.Logger(ctx).Info(
 			fmt.Sprintf("setting new parameter; key: %s, value: %s", c.Key, c.Value),
 		)
 
-		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
+		if err := ss.Update(cacheCtx, []byte(c.Key), []byte(c.Value)); err != nil {
 			return types.ErrSettingParameter(k.codespace, c.Key, c.Value, err.Error())
 		}
+		if !containsString(changed, c.Subspace) {
+			changed = append(changed, c.Subspace)
+		}
 	}
 
+	for _, name := range changed {
+		ss, _ := k.GetSubspace(name)
+		if err := ss.Validate(cacheCtx); err != nil {
+			return types.ErrInvalidParams(k.codespace, name, err.Error())
+		}
+	}
+
+	writeCache()
 	return nil
 }
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
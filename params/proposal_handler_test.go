@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -147,3 +148,80 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+type validatedTestParams struct {
+	testParams
+}
+
+func (tp *validatedTestParams) ParamSetPairs() subspace.ParamSetPairs {
+	return tp.testParams.ParamSetPairs()
+}
+
+func (tp validatedTestParams) Validate() error {
+	if tp.MaxValidators == 0 {
+		return errors.New("max validators must be positive")
+	}
+	return nil
+}
+
+func TestProposalHandlerValidation(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		subspace.NewKeyTable().RegisterParamSet(&validatedTestParams{}),
+	)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	// invalid value is rejected and nothing is stored
+	tp := testProposal(
+		paramTypes.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`),
+		paramTypes.NewParamChange(testSubspace, keyMaxValidators, "0"),
+	)
+	require.Error(t, hdlr(input.ctx, tp))
+	require.False(t, ss.Has(input.ctx, []byte(keySlashingRate)))
+	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+
+	tp = testProposal(paramTypes.NewParamChange(testSubspace, keyMaxValidators, "5"))
+	require.NoError(t, hdlr(input.ctx, tp))
+
+	var param uint16
+	ss.Get(input.ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(5), param)
+}
+
+type immutableTestParams struct {
+	testParams
+}
+
+func (tp *immutableTestParams) ParamSetPairs() subspace.ParamSetPairs {
+	return tp.testParams.ParamSetPairs()
+}
+
+func (tp *immutableTestParams) ImmutableParamKeys() [][]byte {
+	return [][]byte{[]byte(keyMaxValidators)}
+}
+
+func TestProposalHandlerImmutable(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		subspace.NewKeyTable().RegisterParamSet(&immutableTestParams{}),
+	)
+	ss.Set(input.ctx, []byte(keyMaxValidators), uint16(3))
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	// immutable param is rejected along with other changes in proposal
+	tp := testProposal(
+		paramTypes.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`),
+		paramTypes.NewParamChange(testSubspace, keyMaxValidators, "5"),
+	)
+	require.Error(t, hdlr(input.ctx, tp))
+	require.False(t, ss.Has(input.ctx, []byte(keySlashingRate)))
+
+	var param uint16
+	ss.Get(input.ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(3), param)
+
+	tp = testProposal(paramTypes.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`))
+	require.NoError(t, hdlr(input.ctx, tp))
+}
@@ -13,3 +13,17 @@ type ParamSetPairs []ParamSetPair
 type ParamSet interface {
 	ParamSetPairs() ParamSetPairs
 }
+
+// Interface for parameter structs which can check their values, it is used to
+// reject param changes which leave the subspace in an invalid state
+type ValidatableParamSet interface {
+	ParamSet
+	Validate() error
+}
+
+// Interface for parameter structs with keys which are only set at genesis,
+// param change proposals for these keys are rejected
+type ImmutableParamSet interface {
+	ParamSet
+	ImmutableParamKeys() [][]byte
+}
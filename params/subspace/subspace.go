@@ -38,7 +38,8 @@ func NewSubspace(cdc *codec.Codec, key sdk.StoreKey, tkey sdk.StoreKey, name str
 		tkey: tkey,
 		name: []byte(name),
 		table: KeyTable{
-			m: make(map[string]attribute),
+			m:  make(map[string]attribute),
+			ps: &paramSetAttribute{},
 		},
 	}
 
@@ -58,6 +59,11 @@ func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 		s.table.m[k] = v
 	}
 
+	// subspace is copied by value, param set type is shared like the key map
+	if table.ps != nil {
+		s.table.ps.ty = table.ps.ty
+	}
+
 	// Allocate additional capicity for Subspace.name
 	// So we don't have to allocate extra space each time appending to the key
 	name := s.name
@@ -200,6 +206,27 @@ func (s Subspace) SetParamSet(ctx sdk.Context, ps ParamSet) {
 	}
 }
 
+// IsImmutable returns true if parameter can't be changed by param change proposals
+func (s Subspace) IsImmutable(key []byte) bool {
+	attr, ok := s.table.m[string(key)]
+	return ok && attr.immutable
+}
+
+// Validate loads stored values into registered param set and validates them.
+// Subspaces without validatable param set are always valid.
+func (s Subspace) Validate(ctx sdk.Context) error {
+	if s.table.ps == nil || s.table.ps.ty == nil {
+		return nil
+	}
+
+	ps := reflect.New(s.table.ps.ty).Interface().(ValidatableParamSet)
+	for _, pair := range ps.ParamSetPairs() {
+		s.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return ps.Validate()
+}
+
 // Returns name of Subspace
 func (s Subspace) Name() string {
 	return string(s.name)
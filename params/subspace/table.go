@@ -6,11 +6,21 @@ import (
 
 type attribute struct {
 	ty reflect.Type
+
+	// immutable params can't be changed by param change proposals
+	immutable bool
+}
+
+type paramSetAttribute struct {
+	ty reflect.Type
 }
 
 // KeyTable subspaces appropriate type for each parameter key
 type KeyTable struct {
 	m map[string]attribute
+
+	// type of registered param set, used to validate the subspace
+	ps *paramSetAttribute
 }
 
 // Constructs new table
@@ -73,6 +83,21 @@ func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	for _, kvp := range ps.ParamSetPairs() {
 		t = t.RegisterType(kvp.Key, kvp.Value)
 	}
+
+	if _, ok := ps.(ValidatableParamSet); ok {
+		t.ps = &paramSetAttribute{ty: reflect.TypeOf(ps).Elem()}
+	}
+
+	if ips, ok := ps.(ImmutableParamSet); ok {
+		for _, key := range ips.ImmutableParamKeys() {
+			attr, ok := t.m[string(key)]
+			if !ok {
+				panic("immutable parameter key not registered")
+			}
+			attr.immutable = true
+			t.m[string(key)] = attr
+		}
+	}
 	return t
 }
 
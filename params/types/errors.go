@@ -13,6 +13,8 @@ const (
 	CodeUnknownSubspace  sdk.CodeType = 1
 	CodeSettingParameter sdk.CodeType = 2
 	CodeEmptyData        sdk.CodeType = 3
+	CodeInvalidParams    sdk.CodeType = 4
+	CodeImmutableParam   sdk.CodeType = 5
 )
 
 // ErrUnknownSubspace returns an unknown subspace error.
@@ -30,6 +32,16 @@ func ErrEmptyChanges(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEmptyData, "submitted parameter changes are empty")
 }
 
+// ErrInvalidParams returns an error for parameters which fail subspace validation.
+func ErrInvalidParams(codespace sdk.CodespaceType, space, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParams, fmt.Sprintf("invalid parameters for subspace %s: %s", space, msg))
+}
+
+// ErrImmutableParameter returns an error for changing a parameter which is only set at genesis.
+func ErrImmutableParameter(codespace sdk.CodespaceType, space, key string) sdk.Error {
+	return sdk.NewError(codespace, CodeImmutableParam, fmt.Sprintf("parameter %s on subspace %s cannot be changed", key, space))
+}
+
 // ErrEmptySubspace returns an error for an empty subspace.
 func ErrEmptySubspace(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEmptyData, "parameter subspace is empty")
@@ -0,0 +1,7 @@
+package cli
+
+const (
+	FlagProposerAddress = "proposer"
+	FlagSideTxHash      = "hash"
+	FlagVote            = "vote"
+)
@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/sidetx/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmds := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the sidetx module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	queryCmds.AddCommand(
+		client.GetCommands(
+			GetQueryParams(cdc),
+			GetPendingSideTxs(cdc),
+			GetSideTx(cdc),
+			GetVerifySideTx(cdc),
+		)...,
+	)
+
+	return queryCmds
+}
+
+// GetQueryParams implements the params query command.
+func GetQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current sidetx parameters information",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := json.Unmarshal(bz, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetPendingSideTxs shows side-txs waiting for votes
+func GetPendingSideTxs(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending",
+		Args:  cobra.NoArgs,
+		Short: "show side-txs waiting for votes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingSideTx)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetSideTx shows pending side-tx with its votes
+func GetSideTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "side-tx",
+		Short: "show pending side-tx with its votes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := querySideTx(cdc, types.QuerySideTx)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagSideTxHash, "", "--hash=<side-tx hash>")
+	cmd.MarkFlagRequired(FlagSideTxHash)
+	return cmd
+}
+
+// GetVerifySideTx verifies pending side-tx against external chain using node's contract caller
+func GetVerifySideTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "verify pending side-tx against external chain using node's RPC endpoints",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := querySideTx(cdc, types.QueryVerifySideTx)
+			if err != nil {
+				return err
+			}
+
+			var result hmModule.SideTxResult
+			if err := json.Unmarshal(res, &result); err != nil {
+				return err
+			}
+
+			fmt.Println(result.String())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagSideTxHash, "", "--hash=<side-tx hash>")
+	cmd.MarkFlagRequired(FlagSideTxHash)
+	return cmd
+}
+
+func querySideTx(cdc *codec.Codec, query string) ([]byte, error) {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	hash := viper.GetString(FlagSideTxHash)
+	if hash == "" {
+		return nil, errors.New("side-tx hash cannot be empty")
+	}
+
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySideTxParams(hmTypes.HexToHeimdallHash(hash)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query), queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, errors.New("Side-tx not found")
+	}
+
+	return res, nil
+}
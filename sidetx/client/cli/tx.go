@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/sidetx/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Side-tx transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		client.PostCommands(
+			VoteTxCmd(cdc),
+		)...,
+	)
+	return txCmd
+}
+
+// VoteTxCmd will create a side-tx vote tx
+func VoteTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote",
+		Short: "Vote on pending side-tx (yes or no)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			hash := viper.GetString(FlagSideTxHash)
+			if hash == "" {
+				return fmt.Errorf("side-tx hash has to be supplied")
+			}
+
+			result, err := hmModule.ParseSideTxResult(viper.GetString(FlagVote))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSideTxVote(proposer, hmTypes.HexToHeimdallHash(hash), result)
+
+			// broadcast msg with cli
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().String(FlagSideTxHash, "", "--hash=<side-tx hash>")
+	cmd.Flags().String(FlagVote, "", "--vote=<yes or no>")
+	cmd.MarkFlagRequired(FlagSideTxHash)
+	cmd.MarkFlagRequired(FlagVote)
+	return cmd
+}
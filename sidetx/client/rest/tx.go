@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+
+	restClient "github.com/maticnetwork/heimdall/client/rest"
+	sidetxTypes "github.com/maticnetwork/heimdall/sidetx/types"
+	"github.com/maticnetwork/heimdall/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/sidetx/vote",
+		voteHandlerFn(cliCtx),
+	).Methods("POST")
+}
+
+// VoteReq defines the properties of a side-tx vote request's body.
+type VoteReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	SideTxHash string `json:"side_tx_hash" yaml:"side_tx_hash"`
+	Vote       string `json:"vote" yaml:"vote"` // yes or no
+}
+
+// voteHandlerFn - http request handler to vote on pending side-tx
+func voteHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req VoteReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		result, err := hmModule.ParseSideTxResult(req.Vote)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// get msg
+		msg := sidetxTypes.NewMsgSideTxVote(
+			types.HexToHeimdallAddress(req.BaseReq.From),
+			types.HexToHeimdallHash(req.SideTxHash),
+			result,
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,17 @@
+// Package sidetx implements side-tx voting: msgs which depend on external chain data
+// are applied only after more than 2/3 of validator power votes yes on their side-tx result,
+// so that DeliverTx never calls root chain RPC for them.
+//
+// Votes are submitted as MsgSideTxVote txs by the bridge, not through tendermint precommits,
+// as the tendermint version used by heimdall has no side-tx support in consensus.
+//
+// Currently only topup (MsgTopup) is a side-tx msg. Following msgs still verify external
+// chain data in DeliverTx and are to be migrated to side-tx handlers:
+//
+//	checkpoint: MsgCheckpoint (bor headers), MsgCheckpointAck (root chain header block)
+//	staking:    MsgValidatorJoin, MsgStakeUpdate, MsgSignerUpdate, MsgValidatorExit (tx receipt)
+//	clerk:      MsgEventRecord (tx receipt)
+//
+// The tx confirmation check in auth ante handler is kept for these msgs and can be removed
+// once all of them are side-tx msgs.
+package sidetx
@@ -0,0 +1,19 @@
+package sidetx
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/sidetx/types"
+)
+
+// InitGenesis sets sidetx information for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	return types.NewGenesisState(
+		keeper.GetParams(ctx),
+	)
+}
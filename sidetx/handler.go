@@ -0,0 +1,118 @@
+package sidetx
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/sidetx/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+// NewHandler returns a handler for "sidetx" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgSideTxVote:
+			return handleMsgSideTxVote(ctx, k, msg)
+		default:
+			return sdk.ErrUnknownRequest("Unrecognized sidetx Msg type").Result()
+		}
+	}
+}
+
+// NewSideTxMsgHandler wraps msg handler of a side module. Side msgs which pass module
+// checks are stored as pending side-txs, they are applied by post handler after voting.
+func NewSideTxMsgHandler(k Keeper, handler sdk.Handler) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		result := handler(ctx, msg)
+
+		sideMsg, ok := msg.(hmModule.SideTxMsg)
+		if !ok || !result.IsOK() {
+			return result
+		}
+
+		sideTx, err := k.AddSideTx(ctx, sideMsg)
+		if err != nil {
+			return err.Result()
+		}
+
+		result.Events = append(result.Events, sdk.NewEvent(
+			types.EventTypeSideTx,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeySideTxHash, sideTx.Hash.String()),
+		))
+
+		return result
+	}
+}
+
+// handleMsgSideTxVote records validator's vote and applies side-tx once voting is concluded
+func handleMsgSideTxVote(ctx sdk.Context, k Keeper, msg types.MsgSideTxVote) sdk.Result {
+	sideTx, found := k.GetSideTx(ctx, msg.SideTxHash)
+	if !found {
+		return types.ErrSideTxNotFound(k.Codespace(), msg.SideTxHash.String()).Result()
+	}
+
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	_, validator := validatorSet.GetByAddress(msg.From.Bytes())
+	if validator == nil {
+		return types.ErrNotValidator(k.Codespace(), msg.From.String()).Result()
+	}
+
+	if sideTx.HasVoted(validator.ID) {
+		return types.ErrAlreadyVoted(k.Codespace(), msg.SideTxHash.String()).Result()
+	}
+
+	sideTx.Votes = append(sideTx.Votes, types.Vote{
+		ValidatorID: validator.ID,
+		Signer:      validator.Signer,
+		Result:      msg.Result,
+	})
+	k.SetSideTx(ctx, sideTx)
+
+	yes, no, total := k.Tally(ctx, sideTx)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSideTxVote,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySideTxHash, sideTx.Hash.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyVote, msg.Result.String()),
+			sdk.NewAttribute(types.AttributeKeyYesPower, strconv.FormatInt(yes, 10)),
+			sdk.NewAttribute(types.AttributeKeyNoPower, strconv.FormatInt(no, 10)),
+			sdk.NewAttribute(types.AttributeKeyTotalPower, strconv.FormatInt(total, 10)),
+		),
+	)
+
+	if result := k.GetSideTxResult(ctx, sideTx); result != hmModule.VoteSkip {
+		k.Logger(ctx).Info("Side-tx voting concluded", "hash", sideTx.Hash, "result", result, "yes", yes, "no", no, "total", total)
+		ctx.EventManager().EmitEvents(k.ApplySideTx(ctx, sideTx, result).Events)
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// EndBlocker drops side-txs which didn't get quorum within side-tx expiry, post handlers are
+// called with VoteSkip so that modules can clean up
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	expiry := int64(k.GetParams(ctx).SideTxExpiry)
+
+	var expired []types.SideTx
+	k.IterateSideTxsAndApplyFn(ctx, func(sideTx types.SideTx) error {
+		if ctx.BlockHeight()-sideTx.Height >= expiry {
+			expired = append(expired, sideTx)
+		}
+		return nil
+	})
+
+	for _, sideTx := range expired {
+		k.Logger(ctx).Info("Side-tx expired without quorum", "hash", sideTx.Hash, "height", sideTx.Height)
+		ctx.EventManager().EmitEvents(k.ApplySideTx(ctx, sideTx, hmModule.VoteSkip).Events)
+	}
+}
@@ -0,0 +1,179 @@
+package sidetx_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/auth"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/sidetx"
+	sidetxTypes "github.com/maticnetwork/heimdall/sidetx/types"
+	"github.com/maticnetwork/heimdall/topup"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+//
+// Test suite
+//
+
+// HandlerTestSuite integrate test suite context object
+type HandlerTestSuite struct {
+	suite.Suite
+
+	app        *app.HeimdallApp
+	ctx        sdk.Context
+	validators []*hmTypes.Validator
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	// 4 validators with equal power, so that 3 votes are needed for quorum
+	var validatorSet hmTypes.ValidatorSet
+	suite.validators = nil
+	for i := 0; i < 4; i++ {
+		pubKey := hmTypes.NewPubKey(secp256k1.GenPrivKey().PubKey().Bytes())
+		validator := hmTypes.NewValidator(hmTypes.NewValidatorID(uint64(i+1)), 0, 0, 10, pubKey, hmTypes.BytesToHeimdallAddress(pubKey.Address().Bytes()))
+		require.NoError(suite.T(), suite.app.StakingKeeper.AddValidator(suite.ctx, *validator))
+		require.NoError(suite.T(), validatorSet.UpdateWithChangeSet([]*hmTypes.Validator{validator}))
+		suite.validators = append(suite.validators, validator)
+	}
+	require.NoError(suite.T(), suite.app.StakingKeeper.UpdateValidatorSetInStore(suite.ctx, validatorSet))
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+//
+// Tests
+//
+
+func (suite *HandlerTestSuite) TestSideTxMsgHandler() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+
+	msg := suite.newTopupMsg(hmTypes.BytesToHeimdallAddress([]byte("proposer-1")))
+	handler := sidetx.NewSideTxMsgHandler(happ.SideTxKeeper, topup.NewHandler(happ.TopupKeeper, &helper.ContractCaller{}))
+
+	result := handler(ctx, msg)
+	require.True(t, result.IsOK(), "expected topup to be ok, got %v", result)
+
+	hash := sidetxTypes.GetSideTxHash(msg)
+	sideTx, found := happ.SideTxKeeper.GetSideTx(ctx, hash)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight(), sideTx.Height)
+	require.Empty(t, sideTx.Votes)
+
+	// same topup from another proposer is the same side-tx
+	msg.FromAddress = hmTypes.BytesToHeimdallAddress([]byte("proposer-2"))
+	result = handler(ctx, msg)
+	require.False(t, result.IsOK())
+	require.Equal(t, sidetxTypes.CodeSideTxExists, result.Code)
+}
+
+func (suite *HandlerTestSuite) TestSideTxApproved() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+
+	msg := suite.newTopupMsg(hmTypes.BytesToHeimdallAddress([]byte("proposer")))
+	sideTx, err := happ.SideTxKeeper.AddSideTx(ctx, msg)
+	require.Nil(t, err)
+
+	handler := sidetx.NewHandler(happ.SideTxKeeper)
+
+	// 2/4 votes is not enough
+	for _, validator := range suite.validators[:2] {
+		result := handler(ctx, sidetxTypes.NewMsgSideTxVote(validator.Signer, sideTx.Hash, hmModule.VoteYes))
+		require.True(t, result.IsOK(), "expected vote to be ok, got %v", result)
+	}
+	require.True(t, happ.SideTxKeeper.HasSideTx(ctx, sideTx.Hash))
+	require.True(t, happ.BankKeeper.GetCoins(ctx, msg.Signer).IsZero())
+
+	// same validator can't vote again
+	result := handler(ctx, sidetxTypes.NewMsgSideTxVote(suite.validators[0].Signer, sideTx.Hash, hmModule.VoteYes))
+	require.Equal(t, sidetxTypes.CodeAlreadyVoted, result.Code)
+
+	// non validator can't vote
+	result = handler(ctx, sidetxTypes.NewMsgSideTxVote(hmTypes.BytesToHeimdallAddress([]byte("not-validator")), sideTx.Hash, hmModule.VoteYes))
+	require.Equal(t, sidetxTypes.CodeNotValidator, result.Code)
+
+	// 3/4 votes applies topup
+	result = handler(ctx, sidetxTypes.NewMsgSideTxVote(suite.validators[2].Signer, sideTx.Hash, hmModule.VoteYes))
+	require.True(t, result.IsOK(), "expected vote to be ok, got %v", result)
+	require.False(t, happ.SideTxKeeper.HasSideTx(ctx, sideTx.Hash))
+	require.False(t, happ.BankKeeper.GetCoins(ctx, msg.Signer).IsZero())
+	require.False(t, happ.BankKeeper.GetCoins(ctx, msg.FromAddress).IsZero())
+
+	// vote after voting is concluded
+	result = handler(ctx, sidetxTypes.NewMsgSideTxVote(suite.validators[3].Signer, sideTx.Hash, hmModule.VoteYes))
+	require.Equal(t, sidetxTypes.CodeSideTxNotFound, result.Code)
+}
+
+func (suite *HandlerTestSuite) TestSideTxRejected() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+
+	msg := suite.newTopupMsg(hmTypes.BytesToHeimdallAddress([]byte("proposer")))
+	sideTx, err := happ.SideTxKeeper.AddSideTx(ctx, msg)
+	require.Nil(t, err)
+
+	handler := sidetx.NewHandler(happ.SideTxKeeper)
+
+	result := handler(ctx, sidetxTypes.NewMsgSideTxVote(suite.validators[0].Signer, sideTx.Hash, hmModule.VoteNo))
+	require.True(t, result.IsOK(), "expected vote to be ok, got %v", result)
+	require.Equal(t, hmModule.VoteSkip, happ.SideTxKeeper.GetSideTxResult(ctx, suite.getSideTx(sideTx.Hash)))
+
+	// yes can't get above 2/3 anymore
+	result = handler(ctx, sidetxTypes.NewMsgSideTxVote(suite.validators[1].Signer, sideTx.Hash, hmModule.VoteNo))
+	require.True(t, result.IsOK(), "expected vote to be ok, got %v", result)
+	require.False(t, happ.SideTxKeeper.HasSideTx(ctx, sideTx.Hash))
+	require.True(t, happ.BankKeeper.GetCoins(ctx, msg.Signer).IsZero())
+}
+
+func (suite *HandlerTestSuite) TestSideTxExpired() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+
+	msg := suite.newTopupMsg(hmTypes.BytesToHeimdallAddress([]byte("proposer")))
+	sideTx, err := happ.SideTxKeeper.AddSideTx(ctx, msg)
+	require.Nil(t, err)
+
+	expiry := int64(happ.SideTxKeeper.GetParams(ctx).SideTxExpiry)
+
+	sidetx.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+expiry-1), happ.SideTxKeeper)
+	require.True(t, happ.SideTxKeeper.HasSideTx(ctx, sideTx.Hash))
+
+	sidetx.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+expiry), happ.SideTxKeeper)
+	require.False(t, happ.SideTxKeeper.HasSideTx(ctx, sideTx.Hash))
+	require.True(t, happ.BankKeeper.GetCoins(ctx, msg.Signer).IsZero())
+}
+
+func (suite *HandlerTestSuite) TestInvalidVote() {
+	t := suite.T()
+
+	msg := sidetxTypes.NewMsgSideTxVote(suite.validators[0].Signer, hmTypes.HexToHeimdallHash("0x01"), hmModule.VoteSkip)
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+//
+// Helpers
+//
+
+func (suite *HandlerTestSuite) newTopupMsg(proposer hmTypes.HeimdallAddress) topupTypes.MsgTopup {
+	fee := sdk.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(10), auth.DefaultFeeInMatic))
+	signer := hmTypes.BytesToHeimdallAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+	return topupTypes.NewMsgTopup(proposer, 100, signer, fee, hmTypes.HexToHeimdallHash("0x123"), 0, 10)
+}
+
+func (suite *HandlerTestSuite) getSideTx(hash hmTypes.HeimdallHash) sidetxTypes.SideTx {
+	sideTx, found := suite.app.SideTxKeeper.GetSideTx(suite.ctx, hash)
+	require.True(suite.T(), found)
+	return sideTx
+}
@@ -0,0 +1,252 @@
+package sidetx
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/params/subspace"
+	"github.com/maticnetwork/heimdall/sidetx/types"
+	"github.com/maticnetwork/heimdall/staking"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+var (
+	// SideTxPrefixKey represents pending side-tx prefix key
+	SideTxPrefixKey = []byte{0x91}
+)
+
+// Keeper stores pending side-txs and votes of validators on them
+type Keeper struct {
+	// The (unexposed) key used to access the store from the Context.
+	storeKey sdk.StoreKey
+	// The codec codec for binary encoding/decoding of side-txs (must know msgs of all modules)
+	cdc *codec.Codec
+	// code space
+	codespace sdk.CodespaceType
+	// param subspace
+	paramSpace subspace.Subspace
+	// staking keeper
+	sk staking.Keeper
+	// side-tx router
+	router *Router
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	paramSpace subspace.Subspace,
+	codespace sdk.CodespaceType,
+	stakingKeeper staking.Keeper,
+) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:  codespace,
+		sk:         stakingKeeper,
+		router:     NewRouter(),
+	}
+}
+
+// Codespace returns the keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// Router returns side-tx router, shared by all copies of the keeper
+func (k Keeper) Router() *Router {
+	return k.router
+}
+
+//
+// Params
+//
+
+// SetParams sets the sidetx module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the sidetx module's parameters, defaults are used if not set (eg. chain started without sidetx genesis)
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	params = types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeySideTxExpiry, &params.SideTxExpiry)
+	return
+}
+
+//
+// Side-txs
+//
+
+// GetSideTxKey returns key for pending side-tx
+func GetSideTxKey(hash hmTypes.HeimdallHash) []byte {
+	return append(SideTxPrefixKey, hash.Bytes()...)
+}
+
+// AddSideTx stores side msg as pending side-tx
+func (k Keeper) AddSideTx(ctx sdk.Context, msg hmModule.SideTxMsg) (types.SideTx, sdk.Error) {
+	if !k.router.HasRoute(msg.Route()) {
+		return types.SideTx{}, types.ErrNoSideTxHandler(k.Codespace(), msg.Route())
+	}
+
+	sideTx := types.NewSideTx(msg, ctx.BlockHeight())
+	if k.HasSideTx(ctx, sideTx.Hash) {
+		return types.SideTx{}, types.ErrSideTxExists(k.Codespace(), sideTx.Hash.String())
+	}
+
+	k.SetSideTx(ctx, sideTx)
+	return sideTx, nil
+}
+
+// SetSideTx stores pending side-tx
+func (k Keeper) SetSideTx(ctx sdk.Context, sideTx types.SideTx) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetSideTxKey(sideTx.Hash), k.cdc.MustMarshalBinaryBare(sideTx))
+}
+
+// HasSideTx checks if side-tx is pending
+func (k Keeper) HasSideTx(ctx sdk.Context, hash hmTypes.HeimdallHash) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetSideTxKey(hash))
+}
+
+// GetSideTx returns pending side-tx
+func (k Keeper) GetSideTx(ctx sdk.Context, hash hmTypes.HeimdallHash) (sideTx types.SideTx, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetSideTxKey(hash))
+	if bz == nil {
+		return sideTx, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &sideTx)
+	return sideTx, true
+}
+
+// RemoveSideTx removes pending side-tx
+func (k Keeper) RemoveSideTx(ctx sdk.Context, hash hmTypes.HeimdallHash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetSideTxKey(hash))
+}
+
+// GetSideTxs returns all pending side-txs
+func (k Keeper) GetSideTxs(ctx sdk.Context) (sideTxs []types.SideTx) {
+	k.IterateSideTxsAndApplyFn(ctx, func(sideTx types.SideTx) error {
+		sideTxs = append(sideTxs, sideTx)
+		return nil
+	})
+	return
+}
+
+// IterateSideTxsAndApplyFn iterates pending side-txs and applies the given function.
+func (k Keeper) IterateSideTxsAndApplyFn(ctx sdk.Context, f func(sideTx types.SideTx) error) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, SideTxPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sideTx types.SideTx
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &sideTx)
+
+		// call function and return if required
+		if err := f(sideTx); err != nil {
+			return
+		}
+	}
+}
+
+//
+// Votes
+//
+
+// Tally returns voting power of current validators which voted yes and no, and total voting power
+func (k Keeper) Tally(ctx sdk.Context, sideTx types.SideTx) (yes int64, no int64, total int64) {
+	results := make(map[hmTypes.ValidatorID]hmModule.SideTxResult, len(sideTx.Votes))
+	for _, vote := range sideTx.Votes {
+		results[vote.ValidatorID] = vote.Result
+	}
+
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	for _, validator := range validatorSet.Validators {
+		switch results[validator.ID] {
+		case hmModule.VoteYes:
+			yes += validator.VotingPower
+		case hmModule.VoteNo:
+			no += validator.VotingPower
+		}
+	}
+
+	return yes, no, validatorSet.TotalVotingPower()
+}
+
+// GetSideTxResult returns VoteYes once more than 2/3 of voting power voted yes, VoteNo once yes
+// can't get above 2/3 anymore (at least 1/3 voted no), otherwise VoteSkip
+func (k Keeper) GetSideTxResult(ctx sdk.Context, sideTx types.SideTx) hmModule.SideTxResult {
+	yes, no, total := k.Tally(ctx, sideTx)
+	switch {
+	case total == 0:
+		return hmModule.VoteSkip
+	case yes*3 > total*2:
+		return hmModule.VoteYes
+	case no*3 >= total:
+		return hmModule.VoteNo
+	default:
+		return hmModule.VoteSkip
+	}
+}
+
+// VerifySideTx runs side handler of msg module. It calls external chains, so it must
+// only be used outside of the state machine (eg. queries).
+func (k Keeper) VerifySideTx(ctx sdk.Context, msg sdk.Msg) (hmModule.SideTxResult, sdk.Error) {
+	sideHandler, _, ok := k.router.GetRoute(msg.Route())
+	if !ok {
+		return hmModule.VoteSkip, types.ErrNoSideTxHandler(k.Codespace(), msg.Route())
+	}
+
+	return sideHandler(ctx, msg), nil
+}
+
+// ApplySideTx removes side-tx and runs post handler of its module with the result. State changes
+// of failed post handler are discarded, so that side-tx doesn't block voting.
+func (k Keeper) ApplySideTx(ctx sdk.Context, sideTx types.SideTx, result hmModule.SideTxResult) sdk.Result {
+	k.RemoveSideTx(ctx, sideTx.Hash)
+
+	applied := false
+	var events sdk.Events
+
+	if _, postHandler, ok := k.router.GetRoute(sideTx.Msg.Route()); ok {
+		cacheCtx, writeCache := ctx.CacheContext()
+		postResult := postHandler(cacheCtx, sideTx.Msg, result)
+		if postResult.IsOK() {
+			writeCache()
+			applied = true
+			events = postResult.Events
+		} else {
+			k.Logger(ctx).Error("Error while applying side-tx", "hash", sideTx.Hash, "result", result, "log", postResult.Log)
+		}
+	} else {
+		k.Logger(ctx).Error("No post handler for side-tx", "hash", sideTx.Hash, "route", sideTx.Msg.Route())
+	}
+
+	events = append(events, sdk.NewEvent(
+		types.EventTypeSideTxResult,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySideTxHash, sideTx.Hash.String()),
+		sdk.NewAttribute(types.AttributeKeyResult, result.String()),
+		sdk.NewAttribute(types.AttributeKeyApplied, strconv.FormatBool(applied)),
+	))
+
+	return sdk.Result{
+		Events: events,
+	}
+}
@@ -0,0 +1,98 @@
+package sidetx
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/sidetx/types"
+)
+
+// NewQuerier returns a new sdk.Keeper instance.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParams:
+			return queryParams(ctx, req, k)
+		case types.QueryPendingSideTx:
+			return queryPendingSideTxs(ctx, req, k)
+		case types.QuerySideTx:
+			return querySideTx(ctx, req, k)
+		case types.QueryVerifySideTx:
+			return queryVerifySideTx(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown sidetx query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(k.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryPendingSideTxs(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	sideTxs := k.GetSideTxs(ctx)
+	if sideTxs == nil {
+		sideTxs = []types.SideTx{}
+	}
+
+	// keeper codec knows msgs of all modules
+	bz, err := codec.MarshalJSONIndent(k.cdc, sideTxs)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySideTx(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	sideTx, err := getSideTx(ctx, req, k)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, e := codec.MarshalJSONIndent(k.cdc, sideTx)
+	if e != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", e.Error()))
+	}
+	return bz, nil
+}
+
+// queryVerifySideTx runs side handler of pending side-tx. Queries are served outside of
+// consensus, so side handlers can use node's contract caller here.
+func queryVerifySideTx(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	sideTx, err := getSideTx(ctx, req, k)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := k.VerifySideTx(ctx, sideTx.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, e := json.Marshal(result)
+	if e != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", e.Error()))
+	}
+	return bz, nil
+}
+
+func getSideTx(ctx sdk.Context, req abci.RequestQuery, k Keeper) (types.SideTx, sdk.Error) {
+	var params types.QuerySideTxParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return types.SideTx{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	sideTx, found := k.GetSideTx(ctx, params.SideTxHash)
+	if !found {
+		return types.SideTx{}, types.ErrSideTxNotFound(k.Codespace(), params.SideTxHash.String())
+	}
+	return sideTx, nil
+}
@@ -0,0 +1,79 @@
+package sidetx
+
+import (
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+var isAlphaNumeric = regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString
+
+type sideTxRoute struct {
+	sideHandler hmModule.SideTxHandler
+	postHandler hmModule.PostTxHandler
+}
+
+// Router routes side-tx msgs to side and post handlers of their modules
+type Router struct {
+	routes map[string]sideTxRoute
+}
+
+// NewRouter creates new side-tx router
+func NewRouter() *Router {
+	return &Router{
+		routes: make(map[string]sideTxRoute),
+	}
+}
+
+// AddRoute adds side and post handlers for msg route
+func (r *Router) AddRoute(path string, sideHandler hmModule.SideTxHandler, postHandler hmModule.PostTxHandler) *Router {
+	if !isAlphaNumeric(path) {
+		panic("route expressions can only contain alphanumeric characters")
+	}
+
+	if r.HasRoute(path) {
+		panic(fmt.Sprintf("route %s has already been initialized", path))
+	}
+
+	r.routes[path] = sideTxRoute{
+		sideHandler: sideHandler,
+		postHandler: postHandler,
+	}
+	return r
+}
+
+// HasRoute checks if side-tx route exists
+func (r *Router) HasRoute(path string) bool {
+	_, ok := r.routes[path]
+	return ok
+}
+
+// GetRoute returns side and post handlers for msg route
+func (r *Router) GetRoute(path string) (hmModule.SideTxHandler, hmModule.PostTxHandler, bool) {
+	route, ok := r.routes[path]
+	return route.sideHandler, route.postHandler, ok
+}
+
+// RegisterRoutes registers msg and query routes of all modules, same as module manager does. Side and
+// post handlers of side modules are added to side-tx router, and their msg handlers are wrapped so that
+// side msgs are kept pending for voting.
+func RegisterRoutes(mm *module.Manager, k Keeper, router sdk.Router, queryRouter sdk.QueryRouter) {
+	for _, m := range mm.Modules {
+		if m.Route() != "" {
+			handler := m.NewHandler()
+			if sideModule, ok := m.(hmModule.SideModule); ok {
+				k.Router().AddRoute(m.Route(), sideModule.NewSideTxHandler(), sideModule.NewPostTxHandler())
+				handler = NewSideTxMsgHandler(k, handler)
+			}
+			router.AddRoute(m.Route(), handler)
+		}
+
+		if m.QuerierRoute() != "" {
+			queryRouter.AddRoute(m.QuerierRoute(), m.NewQuerierHandler())
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Side-tx errors reserve 3000 ~ 3099.
+const (
+	CodeSideTxExists    sdk.CodeType = 3000
+	CodeSideTxNotFound  sdk.CodeType = 3001
+	CodeInvalidVote     sdk.CodeType = 3002
+	CodeAlreadyVoted    sdk.CodeType = 3003
+	CodeNotValidator    sdk.CodeType = 3004
+	CodeNoSideTxHandler sdk.CodeType = 3005
+)
+
+// ErrSideTxExists is an error for side-tx which is already pending
+func ErrSideTxExists(codespace sdk.CodespaceType, hash string) sdk.Error {
+	return sdk.NewError(codespace, CodeSideTxExists, "side-tx %v is already pending", hash)
+}
+
+// ErrSideTxNotFound is an error for unknown side-tx
+func ErrSideTxNotFound(codespace sdk.CodespaceType, hash string) sdk.Error {
+	return sdk.NewError(codespace, CodeSideTxNotFound, "no pending side-tx %v", hash)
+}
+
+// ErrInvalidVote is an error for invalid vote
+func ErrInvalidVote(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVote, "vote must be yes or no")
+}
+
+// ErrAlreadyVoted is an error for second vote of validator on the same side-tx
+func ErrAlreadyVoted(codespace sdk.CodespaceType, hash string) sdk.Error {
+	return sdk.NewError(codespace, CodeAlreadyVoted, "validator already voted on side-tx %v", hash)
+}
+
+// ErrNotValidator is an error for vote from address which is not a current validator
+func ErrNotValidator(codespace sdk.CodespaceType, address string) sdk.Error {
+	return sdk.NewError(codespace, CodeNotValidator, "%v is not a current validator", address)
+}
+
+// ErrNoSideTxHandler is an error for side msg whose module has no side-tx handlers
+func ErrNoSideTxHandler(codespace sdk.CodespaceType, route string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoSideTxHandler, "no side-tx handler for route %v", route)
+}
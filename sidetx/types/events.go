@@ -0,0 +1,19 @@
+package types
+
+// sidetx module event types
+const (
+	EventTypeSideTx       = "side-tx"
+	EventTypeSideTxVote   = "side-tx-vote"
+	EventTypeSideTxResult = "side-tx-result"
+
+	AttributeKeySideTxHash  = "side-tx-hash"
+	AttributeKeyValidatorID = "validator-id"
+	AttributeKeyVote        = "vote"
+	AttributeKeyResult      = "result"
+	AttributeKeyApplied     = "applied"
+	AttributeKeyYesPower    = "yes-power"
+	AttributeKeyNoPower     = "no-power"
+	AttributeKeyTotalPower  = "total-power"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+//
+// Side-tx vote
+//
+
+var _ sdk.Msg = &MsgSideTxVote{}
+
+// MsgSideTxVote is a validator's vote on pending side-tx
+type MsgSideTxVote struct {
+	From       hmTypes.HeimdallAddress `json:"from"`
+	SideTxHash hmTypes.HeimdallHash    `json:"side_tx_hash"`
+	Result     hmModule.SideTxResult   `json:"result"`
+}
+
+// NewMsgSideTxVote creates new side-tx vote
+func NewMsgSideTxVote(from hmTypes.HeimdallAddress, sideTxHash hmTypes.HeimdallHash, result hmModule.SideTxResult) MsgSideTxVote {
+	return MsgSideTxVote{
+		From:       from,
+		SideTxHash: sideTxHash,
+		Result:     result,
+	}
+}
+
+// Type returns message type
+func (msg MsgSideTxVote) Type() string {
+	return "side-tx-vote"
+}
+
+// Route returns message route
+func (msg MsgSideTxVote) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgSideTxVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSignBytes returns sign bytes
+func (msg MsgSideTxVote) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic validates the message
+func (msg MsgSideTxVote) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+
+	if msg.SideTxHash.Empty() {
+		return ErrSideTxNotFound(DefaultCodespace, msg.SideTxHash.String())
+	}
+
+	if msg.Result != hmModule.VoteYes && msg.Result != hmModule.VoteNo {
+		return ErrInvalidVote(DefaultCodespace)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/maticnetwork/heimdall/params/subspace"
+)
+
+// Default parameter values
+const (
+	DefaultSideTxExpiry uint64 = 100
+)
+
+// Parameter keys
+var (
+	KeySideTxExpiry = []byte("SideTxExpiry")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the sidetx module.
+type Params struct {
+	SideTxExpiry uint64 `json:"side_tx_expiry" yaml:"side_tx_expiry"` // number of blocks after which side-tx without quorum is dropped
+}
+
+// NewParams creates a new Params object
+func NewParams(sideTxExpiry uint64) Params {
+	return Params{
+		SideTxExpiry: sideTxExpiry,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of sidetx module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeySideTxExpiry, Value: &p.SideTxExpiry},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("SideTxExpiry: %d\n", p.SideTxExpiry))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.SideTxExpiry == 0 {
+		return errors.New("side_tx_expiry must be greater than zero")
+	}
+
+	return nil
+}
+
+//
+// Extra functions
+//
+
+// ParamKeyTable for sidetx module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		SideTxExpiry: DefaultSideTxExpiry,
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// query endpoints supported by the sidetx Querier
+const (
+	QueryParams        = "params"
+	QueryPendingSideTx = "pending-side-txs"
+	QuerySideTx        = "side-tx"
+	QueryVerifySideTx  = "verify-side-tx"
+)
+
+// QuerySideTxParams defines the params for querying side-tx by hash
+type QuerySideTxParams struct {
+	SideTxHash hmTypes.HeimdallHash `json:"side_tx_hash"`
+}
+
+// NewQuerySideTxParams creates a new instance of QuerySideTxParams.
+func NewQuerySideTxParams(sideTxHash hmTypes.HeimdallHash) QuerySideTxParams {
+	return QuerySideTxParams{SideTxHash: sideTxHash}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+// Vote is a validator's vote on side-tx
+type Vote struct {
+	ValidatorID hmTypes.ValidatorID     `json:"validator_id" yaml:"validator_id"`
+	Signer      hmTypes.HeimdallAddress `json:"signer" yaml:"signer"`
+	Result      hmModule.SideTxResult   `json:"result" yaml:"result"`
+}
+
+// SideTx is a side-tx msg waiting for votes of validators
+type SideTx struct {
+	Hash   hmTypes.HeimdallHash `json:"hash" yaml:"hash"`
+	Msg    sdk.Msg              `json:"msg" yaml:"msg"`
+	Height int64                `json:"height" yaml:"height"` // height at which side-tx was submitted
+	Votes  []Vote               `json:"votes" yaml:"votes"`
+}
+
+// NewSideTx creates side-tx for msg submitted at height
+func NewSideTx(msg hmModule.SideTxMsg, height int64) SideTx {
+	return SideTx{
+		Hash:   GetSideTxHash(msg),
+		Msg:    msg,
+		Height: height,
+	}
+}
+
+// HasVoted checks if validator already voted on side-tx
+func (s SideTx) HasVoted(validatorID hmTypes.ValidatorID) bool {
+	for _, vote := range s.Votes {
+		if vote.ValidatorID == validatorID {
+			return true
+		}
+	}
+	return false
+}
+
+// HasSignerVoted checks if validator with given signer already voted on side-tx
+func (s SideTx) HasSignerVoted(signer hmTypes.HeimdallAddress) bool {
+	for _, vote := range s.Votes {
+		if vote.Signer.Equals(signer) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSideTxHash returns side-tx hash of msg
+func GetSideTxHash(msg hmModule.SideTxMsg) hmTypes.HeimdallHash {
+	return hmTypes.BytesToHeimdallHash(tmhash.Sum(msg.GetSideSignBytes()))
+}
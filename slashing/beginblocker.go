@@ -0,0 +1,25 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// BeginBlocker counts signatures of validators in last commit and handles evidence of misbehaviour
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	k.ResetMissedBlocksOnWindowChange(ctx)
+
+	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
+		k.HandleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.SignedLastBlock)
+	}
+
+	for _, evidence := range req.ByzantineValidators {
+		switch evidence.Type {
+		case tmTypes.ABCIEvidenceTypeDuplicateVote:
+			k.HandleDoubleSign(ctx, evidence)
+		default:
+			k.Logger(ctx).Error("Ignored evidence of unknown type", "type", evidence.Type)
+		}
+	}
+}
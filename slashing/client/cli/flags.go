@@ -0,0 +1,6 @@
+package cli
+
+const (
+	FlagProposerAddress = "proposer"
+	FlagValidatorID     = "id"
+)
@@ -0,0 +1,55 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+
+	restClient "github.com/maticnetwork/heimdall/client/rest"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	"github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/slashing/unjail",
+		unjailHandlerFn(cliCtx),
+	).Methods("POST")
+}
+
+// UnjailReq defines the properties of an unjail request's body.
+type UnjailReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	ID uint64 `json:"id" yaml:"id"`
+}
+
+// unjailHandlerFn - http request handler to unjail validator
+func unjailHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UnjailReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// get msg
+		msg := slashingTypes.NewMsgUnjail(
+			types.HexToHeimdallAddress(req.BaseReq.From),
+			types.ValidatorID(req.ID),
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,36 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// InitGenesis sets slashing information for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	// missed blocks bitmaps are tracked for window of genesis params
+	keeper.SetSignedBlocksWindow(ctx, data.Params.SignedBlocksWindow)
+
+	for _, info := range data.SigningInfos {
+		keeper.SetValidatorSigningInfo(ctx, info)
+	}
+
+	for _, missed := range data.MissedBlocks {
+		for _, offset := range missed.MissedBlocks {
+			keeper.SetValidatorMissedBlock(ctx, missed.ValidatorID, offset, true)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	// bitmaps of old window are not exported if window was changed after last begin block
+	keeper.ResetMissedBlocksOnWindowChange(ctx)
+
+	return types.NewGenesisState(
+		keeper.GetParams(ctx),
+		keeper.GetAllValidatorSigningInfos(ctx),
+		keeper.GetAllValidatorMissedBlocks(ctx),
+	)
+}
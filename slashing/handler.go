@@ -0,0 +1,72 @@
+package slashing
+
+import (
+	"bytes"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// NewHandler returns a handler for "slashing" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgUnjail:
+			return handleMsgUnjail(ctx, k, msg)
+		default:
+			return sdk.ErrUnknownRequest("Unrecognized slashing Msg type").Result()
+		}
+	}
+}
+
+// handleMsgUnjail brings validator jailed for downtime back once downtime jail duration has passed
+func handleMsgUnjail(ctx sdk.Context, k Keeper, msg types.MsgUnjail) sdk.Result {
+	validator, ok := k.sk.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		return types.ErrNoValidator(k.Codespace(), msg.ID.String()).Result()
+	}
+
+	if !bytes.Equal(validator.Signer.Bytes(), msg.From.Bytes()) {
+		return types.ErrInvalidSigner(k.Codespace(), msg.From.String()).Result()
+	}
+
+	if !validator.Jailed {
+		return types.ErrValidatorNotJailed(k.Codespace(), msg.ID.String()).Result()
+	}
+
+	info := k.getOrCreateSigningInfo(ctx, validator.ID)
+	if info.Tombstoned {
+		return types.ErrValidatorTombstoned(k.Codespace(), msg.ID.String()).Result()
+	}
+
+	if ctx.BlockHeader().Time.Before(info.JailedUntil) {
+		return types.ErrValidatorJailed(k.Codespace(), msg.ID.String(), info.JailedUntil.UTC().Format(time.RFC3339)).Result()
+	}
+
+	if err := k.sk.UnjailValidator(ctx, validator.ID); err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+
+	// validator gets full window before being judged again
+	info.StartHeight = ctx.BlockHeight()
+	k.SetValidatorSigningInfo(ctx, info)
+
+	k.Logger(ctx).Info("Validator unjailed", "validatorID", validator.ID, "signer", validator.Signer)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
+			sdk.NewAttribute(types.AttributeKeySigner, validator.Signer.String()),
+		),
+	)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -0,0 +1,448 @@
+package slashing
+
+import (
+	"encoding/binary"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/params/subspace"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	"github.com/maticnetwork/heimdall/staking"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var (
+	// ValidatorSigningInfoKey represents signing info prefix key
+	ValidatorSigningInfoKey = []byte{0xA1}
+
+	// ValidatorMissedBlockKey represents prefix key of missed blocks bitmap of validators
+	ValidatorMissedBlockKey = []byte{0xA2}
+
+	// SignedBlocksWindowKey represents key of signed blocks window which missed blocks bitmaps are tracked for
+	SignedBlocksWindowKey = []byte{0xA3}
+
+	// DefaultValue is stored for missed block in bitmap
+	DefaultValue = []byte{0x01}
+
+	// power is stake in tokens with 18 decimals
+	decimals18 = big.NewInt(0).Exp(big.NewInt(10), big.NewInt(18), nil)
+)
+
+// Keeper tracks signed blocks of validators and slashes them for misbehaviour
+type Keeper struct {
+	// The (unexposed) key used to access the store from the Context.
+	storeKey sdk.StoreKey
+	// The codec codec for binary encoding/decoding
+	cdc *codec.Codec
+	// code space
+	codespace sdk.CodespaceType
+	// param subspace
+	paramSpace subspace.Subspace
+	// staking keeper
+	sk staking.Keeper
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	paramSpace subspace.Subspace,
+	codespace sdk.CodespaceType,
+	stakingKeeper staking.Keeper,
+) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:  codespace,
+		sk:         stakingKeeper,
+	}
+}
+
+// Codespace returns the keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+//
+// Params
+//
+
+// SetParams sets the slashing module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the slashing module's parameters, defaults are used if not set (eg. chain started without slashing genesis)
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	params = types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeySignedBlocksWindow, &params.SignedBlocksWindow)
+	k.paramSpace.GetIfExists(ctx, types.KeyMinSignedPerWindow, &params.MinSignedPerWindow)
+	k.paramSpace.GetIfExists(ctx, types.KeyDowntimeJailDuration, &params.DowntimeJailDuration)
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxEvidenceAge, &params.MaxEvidenceAge)
+	k.paramSpace.GetIfExists(ctx, types.KeySlashFractionDoubleSign, &params.SlashFractionDoubleSign)
+	return
+}
+
+//
+// Signing info
+//
+
+// GetValidatorSigningInfoKey returns key for signing info of validator
+func GetValidatorSigningInfoKey(id hmTypes.ValidatorID) []byte {
+	return append(ValidatorSigningInfoKey, sdk.Uint64ToBigEndian(id.Uint64())...)
+}
+
+// SetValidatorSigningInfo stores signing info of validator
+func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, info types.ValidatorSigningInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorSigningInfoKey(info.ValidatorID), k.cdc.MustMarshalBinaryBare(info))
+}
+
+// GetValidatorSigningInfo returns signing info of validator
+func (k Keeper) GetValidatorSigningInfo(ctx sdk.Context, id hmTypes.ValidatorID) (info types.ValidatorSigningInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorSigningInfoKey(id))
+	if bz == nil {
+		return info, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, true
+}
+
+// GetAllValidatorSigningInfos returns signing infos of all validators
+func (k Keeper) GetAllValidatorSigningInfos(ctx sdk.Context) (infos []types.ValidatorSigningInfo) {
+	k.IterateValidatorSigningInfosAndApplyFn(ctx, func(info types.ValidatorSigningInfo) error {
+		infos = append(infos, info)
+		return nil
+	})
+	return
+}
+
+// IterateValidatorSigningInfosAndApplyFn iterates signing infos and applies the given function.
+func (k Keeper) IterateValidatorSigningInfosAndApplyFn(ctx sdk.Context, f func(info types.ValidatorSigningInfo) error) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorSigningInfoKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.ValidatorSigningInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+
+		// call function and return if required
+		if err := f(info); err != nil {
+			return
+		}
+	}
+}
+
+// getOrCreateSigningInfo returns signing info of validator, starting at current height if not seen before
+func (k Keeper) getOrCreateSigningInfo(ctx sdk.Context, id hmTypes.ValidatorID) types.ValidatorSigningInfo {
+	if info, found := k.GetValidatorSigningInfo(ctx, id); found {
+		return info
+	}
+	return types.NewValidatorSigningInfo(id, ctx.BlockHeight())
+}
+
+//
+// Missed blocks
+//
+
+// GetValidatorMissedBlockPrefixKey returns prefix key for missed blocks bitmap of validator
+func GetValidatorMissedBlockPrefixKey(id hmTypes.ValidatorID) []byte {
+	return append(ValidatorMissedBlockKey, sdk.Uint64ToBigEndian(id.Uint64())...)
+}
+
+// GetValidatorMissedBlockKey returns key for block at given offset in missed blocks bitmap of validator
+func GetValidatorMissedBlockKey(id hmTypes.ValidatorID, offset int64) []byte {
+	return append(GetValidatorMissedBlockPrefixKey(id), sdk.Uint64ToBigEndian(uint64(offset))...)
+}
+
+// SetValidatorMissedBlock sets if validator missed block at given offset in signed blocks window
+func (k Keeper) SetValidatorMissedBlock(ctx sdk.Context, id hmTypes.ValidatorID, offset int64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	if missed {
+		store.Set(GetValidatorMissedBlockKey(id, offset), DefaultValue)
+	} else {
+		store.Delete(GetValidatorMissedBlockKey(id, offset))
+	}
+}
+
+// GetValidatorMissedBlock checks if validator missed block at given offset in signed blocks window
+func (k Keeper) GetValidatorMissedBlock(ctx sdk.Context, id hmTypes.ValidatorID, offset int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetValidatorMissedBlockKey(id, offset))
+}
+
+// GetValidatorMissedBlocks returns offsets of blocks missed by validator in signed blocks window
+func (k Keeper) GetValidatorMissedBlocks(ctx sdk.Context, id hmTypes.ValidatorID) (offsets []int64) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := GetValidatorMissedBlockPrefixKey(id)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		offsets = append(offsets, int64(binary.BigEndian.Uint64(iterator.Key()[len(prefix):])))
+	}
+	return
+}
+
+// GetAllValidatorMissedBlocks returns missed blocks bitmaps of all validators with signing info
+func (k Keeper) GetAllValidatorMissedBlocks(ctx sdk.Context) (missedBlocks []types.ValidatorMissedBlocks) {
+	k.IterateValidatorSigningInfosAndApplyFn(ctx, func(info types.ValidatorSigningInfo) error {
+		if offsets := k.GetValidatorMissedBlocks(ctx, info.ValidatorID); len(offsets) > 0 {
+			missedBlocks = append(missedBlocks, types.ValidatorMissedBlocks{
+				ValidatorID:  info.ValidatorID,
+				MissedBlocks: offsets,
+			})
+		}
+		return nil
+	})
+	return
+}
+
+// clearValidatorMissedBlocks removes missed blocks bitmap of validator
+func (k Keeper) clearValidatorMissedBlocks(ctx sdk.Context, id hmTypes.ValidatorID) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, GetValidatorMissedBlockPrefixKey(id))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetSignedBlocksWindow returns signed blocks window which missed blocks bitmaps are tracked for
+func (k Keeper) GetSignedBlocksWindow(ctx sdk.Context) (window int64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(SignedBlocksWindowKey)
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// SetSignedBlocksWindow stores signed blocks window which missed blocks bitmaps are tracked for
+func (k Keeper) SetSignedBlocksWindow(ctx sdk.Context, window int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(SignedBlocksWindowKey, sdk.Uint64ToBigEndian(uint64(window)))
+}
+
+// ResetMissedBlocksOnWindowChange starts new window for all validators once signed blocks window
+// is changed by governance, as offsets in bitmaps of old window don't match blocks of new window.
+func (k Keeper) ResetMissedBlocksOnWindowChange(ctx sdk.Context) {
+	window := k.GetParams(ctx).SignedBlocksWindow
+	tracked, found := k.GetSignedBlocksWindow(ctx)
+	if found && tracked == window {
+		return
+	}
+	k.SetSignedBlocksWindow(ctx, window)
+
+	// bitmaps are empty before first window is tracked
+	if !found {
+		return
+	}
+
+	for _, info := range k.GetAllValidatorSigningInfos(ctx) {
+		info.StartHeight = ctx.BlockHeight()
+		info.IndexOffset = 0
+		info.MissedBlocksCounter = 0
+		k.clearValidatorMissedBlocks(ctx, info.ValidatorID)
+		k.SetValidatorSigningInfo(ctx, info)
+	}
+
+	k.Logger(ctx).Info("Signed blocks window changed, missed blocks reset", "oldWindow", tracked, "window", window)
+}
+
+// GetValidatorLiveness returns missed blocks stats of validator
+func (k Keeper) GetValidatorLiveness(ctx sdk.Context, id hmTypes.ValidatorID) (liveness types.ValidatorLiveness, found bool) {
+	info, found := k.GetValidatorSigningInfo(ctx, id)
+	if !found {
+		return liveness, false
+	}
+
+	params := k.GetParams(ctx)
+	liveness = types.ValidatorLiveness{
+		SigningInfo:        info,
+		SignedBlocksWindow: params.SignedBlocksWindow,
+		MaxMissedBlocks:    params.SignedBlocksWindow - params.MinSignedBlocks(),
+		MissedBlocks:       k.GetValidatorMissedBlocks(ctx, id),
+	}
+	if validator, ok := k.sk.GetValidatorFromValID(ctx, id); ok {
+		liveness.Jailed = validator.Jailed
+	}
+	return liveness, true
+}
+
+//
+// Infractions
+//
+
+// HandleValidatorSignature tracks missed blocks of validator in sliding signed blocks window. Validator
+// which misses more blocks than allowed is jailed until unjail after downtime jail duration.
+func (k Keeper) HandleValidatorSignature(ctx sdk.Context, signer []byte, signed bool) {
+	validator, err := k.sk.GetValidatorInfo(ctx, signer)
+	if err != nil {
+		k.Logger(ctx).Error("Ignored signature of unknown validator", "signer", hmTypes.BytesToHeimdallAddress(signer), "error", err)
+		return
+	}
+
+	params := k.GetParams(ctx)
+	info := k.getOrCreateSigningInfo(ctx, validator.ID)
+
+	// offset of this block in window, overwrites block which left the window
+	offset := info.IndexOffset % params.SignedBlocksWindow
+	info.IndexOffset++
+
+	missed := !signed
+	previous := k.GetValidatorMissedBlock(ctx, validator.ID, offset)
+	switch {
+	case !previous && missed:
+		k.SetValidatorMissedBlock(ctx, validator.ID, offset, true)
+		info.MissedBlocksCounter++
+	case previous && !missed:
+		k.SetValidatorMissedBlock(ctx, validator.ID, offset, false)
+		info.MissedBlocksCounter--
+	}
+
+	if missed {
+		k.Logger(ctx).Debug("Validator missed block", "validatorID", validator.ID, "missed", info.MissedBlocksCounter, "window", params.SignedBlocksWindow)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLiveness,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
+				sdk.NewAttribute(types.AttributeKeyMissedBlocks, strconv.FormatInt(info.MissedBlocksCounter, 10)),
+			),
+		)
+	}
+
+	// validator is judged only after full window since start height
+	maxMissed := params.SignedBlocksWindow - params.MinSignedBlocks()
+	if ctx.BlockHeight() > info.StartHeight+params.SignedBlocksWindow && info.MissedBlocksCounter > maxMissed && !validator.Jailed {
+		k.handleDowntime(ctx, validator, &info, params)
+	}
+
+	k.SetValidatorSigningInfo(ctx, info)
+}
+
+// handleDowntime jails validator for missing too many blocks and starts new window for it
+func (k Keeper) handleDowntime(ctx sdk.Context, validator hmTypes.Validator, info *types.ValidatorSigningInfo, params types.Params) {
+	if err := k.sk.JailValidator(ctx, validator.ID); err != nil {
+		k.Logger(ctx).Error("Unable to jail validator", "validatorID", validator.ID, "error", err)
+		return
+	}
+
+	missed := info.MissedBlocksCounter
+	info.JailedUntil = ctx.BlockHeader().Time.Add(params.DowntimeJailDuration)
+	info.IndexOffset = 0
+	info.MissedBlocksCounter = 0
+	k.clearValidatorMissedBlocks(ctx, validator.ID)
+
+	k.Logger(ctx).Info("Validator jailed for downtime",
+		"validatorID", validator.ID,
+		"signer", validator.Signer,
+		"missed", missed,
+		"jailedUntil", info.JailedUntil,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
+			sdk.NewAttribute(types.AttributeKeySigner, validator.Signer.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(validator.VotingPower, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
+			sdk.NewAttribute(types.AttributeKeyJailed, strconv.FormatBool(true)),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, info.JailedUntil.UTC().Format(time.RFC3339)),
+		),
+	)
+}
+
+// HandleDoubleSign slashes and jails validator for duplicate vote. Validator is tombstoned,
+// so that further evidence of the same validator is ignored.
+func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence abci.Evidence) {
+	params := k.GetParams(ctx)
+	signer := hmTypes.BytesToHeimdallAddress(evidence.Validator.Address)
+
+	// ignore evidence which can't be handled anymore
+	if age := ctx.BlockHeader().Time.Sub(evidence.Time); age > params.MaxEvidenceAge {
+		k.Logger(ctx).Info("Ignored double sign evidence, too old", "signer", signer, "height", evidence.Height, "age", age)
+		return
+	}
+
+	validator, err := k.sk.GetValidatorInfo(ctx, signer.Bytes())
+	if err != nil {
+		k.Logger(ctx).Error("Ignored double sign of unknown validator", "signer", signer, "error", err)
+		return
+	}
+
+	info := k.getOrCreateSigningInfo(ctx, validator.ID)
+	if info.Tombstoned {
+		k.Logger(ctx).Info("Ignored double sign evidence, validator already tombstoned", "validatorID", validator.ID, "height", evidence.Height)
+		return
+	}
+
+	// power at infraction height is slashed
+	amount := k.Slash(ctx, validator.ID, evidence.Validator.Power, params.SlashFractionDoubleSign)
+
+	if err := k.sk.JailValidator(ctx, validator.ID); err != nil {
+		k.Logger(ctx).Error("Unable to jail validator", "validatorID", validator.ID, "error", err)
+	}
+
+	info.Tombstoned = true
+	k.SetValidatorSigningInfo(ctx, info)
+
+	k.Logger(ctx).Info("Validator slashed for double signing",
+		"validatorID", validator.ID,
+		"signer", signer,
+		"height", evidence.Height,
+		"power", evidence.Validator.Power,
+		"slashedAmount", amount,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
+			sdk.NewAttribute(types.AttributeKeySigner, signer.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(evidence.Validator.Power, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyJailed, strconv.FormatBool(true)),
+		),
+	)
+}
+
+// Slash adds fraction of stake to slashed amount of validator's dividend account. Slashed amounts
+// go to account root of next checkpoint, rootchain enforces them.
+func (k Keeper) Slash(ctx sdk.Context, id hmTypes.ValidatorID, power int64, fraction sdk.Dec) *big.Int {
+	stake := sdk.NewIntFromBigInt(big.NewInt(0).Mul(big.NewInt(power), decimals18))
+	amount := sdk.NewDecFromInt(stake).Mul(fraction).TruncateInt().BigInt()
+	if amount.Sign() > 0 {
+		k.sk.AddSlashedAmountToDividendAccount(ctx, id, amount)
+	}
+	return amount
+}
@@ -0,0 +1,272 @@
+package slashing_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/slashing"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Test suite
+//
+
+// KeeperTestSuite integrate test suite context object
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app        *app.HeimdallApp
+	ctx        sdk.Context
+	validators []*hmTypes.Validator
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Now()})
+
+	var validatorSet hmTypes.ValidatorSet
+	suite.validators = nil
+	for i := 0; i < 4; i++ {
+		pubKey := hmTypes.NewPubKey(secp256k1.GenPrivKey().PubKey().Bytes())
+		validator := hmTypes.NewValidator(hmTypes.NewValidatorID(uint64(i+1)), 0, 0, 10, pubKey, hmTypes.BytesToHeimdallAddress(pubKey.Address().Bytes()))
+		require.NoError(suite.T(), suite.app.StakingKeeper.AddValidator(suite.ctx, *validator))
+		require.NoError(suite.T(), validatorSet.UpdateWithChangeSet([]*hmTypes.Validator{validator}))
+		suite.validators = append(suite.validators, validator)
+	}
+	require.NoError(suite.T(), suite.app.StakingKeeper.UpdateValidatorSetInStore(suite.ctx, validatorSet))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+//
+// Tests
+//
+
+func (suite *KeeperTestSuite) TestHandleDoubleSign() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+	validator := suite.validators[0]
+
+	slashing.BeginBlocker(ctx, abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Evidence{suite.newEvidence(validator, ctx.BlockHeader().Time)},
+	}, happ.SlashingKeeper)
+
+	// 5% of 10 tokens
+	expected := big.NewInt(0).Mul(big.NewInt(5), big.NewInt(0).Exp(big.NewInt(10), big.NewInt(17), nil))
+	dividendAccount, err := happ.StakingKeeper.GetDividendAccountByID(ctx, hmTypes.DividendAccountID(validator.ID))
+	require.NoError(t, err)
+	require.Equal(t, expected.String(), dividendAccount.SlashedAmount)
+
+	info, found := happ.SlashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.True(t, found)
+	require.True(t, info.Tombstoned)
+
+	// jailed validator is removed with next validator set update
+	jailed, ok := happ.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, ok)
+	require.True(t, jailed.Jailed)
+	require.True(t, happ.StakingKeeper.IsValidatorSetChanged(ctx))
+
+	validatorSet := happ.StakingKeeper.GetValidatorSet(ctx)
+	updates := helper.GetUpdatedValidators(&validatorSet, happ.StakingKeeper.GetAllValidators(ctx), 0)
+	require.Len(t, updates, 1)
+	require.Equal(t, validator.ID, updates[0].ID)
+	require.Equal(t, int64(0), updates[0].VotingPower)
+
+	// tombstoned validator is slashed only once
+	slashing.BeginBlocker(ctx, abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Evidence{suite.newEvidence(validator, ctx.BlockHeader().Time)},
+	}, happ.SlashingKeeper)
+
+	dividendAccount, err = happ.StakingKeeper.GetDividendAccountByID(ctx, hmTypes.DividendAccountID(validator.ID))
+	require.NoError(t, err)
+	require.Equal(t, expected.String(), dividendAccount.SlashedAmount)
+}
+
+func (suite *KeeperTestSuite) TestHandleOldDoubleSign() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+	validator := suite.validators[0]
+
+	maxAge := happ.SlashingKeeper.GetParams(ctx).MaxEvidenceAge
+	slashing.BeginBlocker(ctx, abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Evidence{suite.newEvidence(validator, ctx.BlockHeader().Time.Add(-maxAge-time.Second))},
+	}, happ.SlashingKeeper)
+
+	require.False(t, happ.StakingKeeper.CheckIfDividendAccountExists(ctx, hmTypes.DividendAccountID(validator.ID)))
+	jailed, _ := happ.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+	require.False(t, jailed.Jailed)
+}
+
+func (suite *KeeperTestSuite) TestSignedBlocksWindow() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+	validator := suite.validators[0]
+	window := happ.SlashingKeeper.GetParams(ctx).SignedBlocksWindow
+
+	for i := int64(0); i < window; i++ {
+		happ.SlashingKeeper.HandleValidatorSignature(ctx, validator.Signer.Bytes(), i%2 == 0)
+	}
+
+	info, found := happ.SlashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.True(t, found)
+	require.Equal(t, window, info.IndexOffset)
+	require.Equal(t, window/2, info.MissedBlocksCounter)
+	require.Len(t, happ.SlashingKeeper.GetValidatorMissedBlocks(ctx, validator.ID), int(window/2))
+
+	// first block of window slides out, signed blocks replace missed ones
+	happ.SlashingKeeper.HandleValidatorSignature(ctx, validator.Signer.Bytes(), false)
+	happ.SlashingKeeper.HandleValidatorSignature(ctx, validator.Signer.Bytes(), true)
+	info, _ = happ.SlashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.Equal(t, window+2, info.IndexOffset)
+	require.Equal(t, window/2, info.MissedBlocksCounter)
+}
+
+func (suite *KeeperTestSuite) TestSignedBlocksWindowChange() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+	validator := suite.validators[0]
+	params := happ.SlashingKeeper.GetParams(ctx)
+
+	// missed blocks are counted in window of current params
+	votes := []abci.VoteInfo{{Validator: abci.Validator{Address: validator.Signer.Bytes(), Power: validator.VotingPower}}}
+	for i := int64(0); i < params.SignedBlocksWindow/2; i++ {
+		slashing.BeginBlocker(ctx, abci.RequestBeginBlock{LastCommitInfo: abci.LastCommitInfo{Votes: votes}}, happ.SlashingKeeper)
+	}
+	info, _ := happ.SlashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.Equal(t, params.SignedBlocksWindow/2, info.MissedBlocksCounter)
+
+	// window changed by governance, offsets of old window are reset
+	params.SignedBlocksWindow = params.SignedBlocksWindow / 4
+	happ.SlashingKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	slashing.BeginBlocker(ctx, abci.RequestBeginBlock{}, happ.SlashingKeeper)
+
+	info, _ = happ.SlashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.Equal(t, ctx.BlockHeight(), info.StartHeight)
+	require.Equal(t, int64(0), info.IndexOffset)
+	require.Equal(t, int64(0), info.MissedBlocksCounter)
+	require.Empty(t, happ.SlashingKeeper.GetValidatorMissedBlocks(ctx, validator.ID))
+
+	window, found := happ.SlashingKeeper.GetSignedBlocksWindow(ctx)
+	require.True(t, found)
+	require.Equal(t, params.SignedBlocksWindow, window)
+
+	// counter matches bitmap of new window
+	for i := int64(0); i < params.SignedBlocksWindow+2; i++ {
+		slashing.BeginBlocker(ctx, abci.RequestBeginBlock{LastCommitInfo: abci.LastCommitInfo{Votes: votes}}, happ.SlashingKeeper)
+	}
+	info, _ = happ.SlashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.Equal(t, params.SignedBlocksWindow, info.MissedBlocksCounter)
+	require.Len(t, happ.SlashingKeeper.GetValidatorMissedBlocks(ctx, validator.ID), int(params.SignedBlocksWindow))
+}
+
+func (suite *KeeperTestSuite) TestHandleDowntime() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+	validator := suite.validators[0]
+	params := happ.SlashingKeeper.GetParams(ctx)
+	maxMissed := params.SignedBlocksWindow - params.MinSignedBlocks()
+
+	// validator is not judged before full window since start height
+	height := ctx.BlockHeight()
+	for i := int64(0); i <= params.SignedBlocksWindow; i++ {
+		ctx = ctx.WithBlockHeight(height + i)
+		happ.SlashingKeeper.HandleValidatorSignature(ctx, validator.Signer.Bytes(), false)
+	}
+
+	jailed, _ := happ.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+	require.False(t, jailed.Jailed)
+
+	info, _ := happ.SlashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.True(t, info.MissedBlocksCounter > maxMissed)
+
+	// next missed block jails validator
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	happ.SlashingKeeper.HandleValidatorSignature(ctx, validator.Signer.Bytes(), false)
+
+	jailed, _ = happ.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, jailed.Jailed)
+	require.True(t, happ.StakingKeeper.IsValidatorSetChanged(ctx))
+
+	info, _ = happ.SlashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.Equal(t, ctx.BlockHeader().Time.Add(params.DowntimeJailDuration), info.JailedUntil)
+	require.Equal(t, int64(0), info.MissedBlocksCounter)
+	require.Empty(t, happ.SlashingKeeper.GetValidatorMissedBlocks(ctx, validator.ID))
+
+	// downtime is not slashed
+	require.False(t, happ.StakingKeeper.CheckIfDividendAccountExists(ctx, hmTypes.DividendAccountID(validator.ID)))
+}
+
+func (suite *KeeperTestSuite) TestHandleMsgUnjail() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+	validator := suite.validators[0]
+	handler := slashing.NewHandler(happ.SlashingKeeper)
+	msg := types.NewMsgUnjail(validator.Signer, validator.ID)
+
+	// validator is not jailed
+	result := handler(ctx, msg)
+	require.Equal(t, types.CodeValidatorNotJailed, result.Code)
+
+	params := happ.SlashingKeeper.GetParams(ctx)
+	info := types.NewValidatorSigningInfo(validator.ID, ctx.BlockHeight())
+	info.JailedUntil = ctx.BlockHeader().Time.Add(params.DowntimeJailDuration)
+	happ.SlashingKeeper.SetValidatorSigningInfo(ctx, info)
+	require.NoError(t, happ.StakingKeeper.JailValidator(ctx, validator.ID))
+
+	// only validator signer can unjail
+	result = handler(ctx, types.NewMsgUnjail(suite.validators[1].Signer, validator.ID))
+	require.Equal(t, types.CodeInvalidSigner, result.Code)
+
+	// downtime jail duration has not passed
+	result = handler(ctx, msg)
+	require.Equal(t, types.CodeValidatorJailed, result.Code)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(info.JailedUntil)
+	result = handler(ctx, msg)
+	require.True(t, result.IsOK(), result.Log)
+
+	unjailed, _ := happ.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+	require.False(t, unjailed.Jailed)
+
+	info, _ = happ.SlashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.Equal(t, ctx.BlockHeight(), info.StartHeight)
+}
+
+func (suite *KeeperTestSuite) TestHandleMsgUnjailTombstoned() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+	validator := suite.validators[0]
+
+	slashing.BeginBlocker(ctx, abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Evidence{suite.newEvidence(validator, ctx.BlockHeader().Time)},
+	}, happ.SlashingKeeper)
+
+	// double signer stays jailed
+	result := slashing.NewHandler(happ.SlashingKeeper)(ctx, types.NewMsgUnjail(validator.Signer, validator.ID))
+	require.Equal(t, types.CodeValidatorTombstoned, result.Code)
+}
+
+//
+// Helpers
+//
+
+func (suite *KeeperTestSuite) newEvidence(validator *hmTypes.Validator, evidenceTime time.Time) abci.Evidence {
+	return abci.Evidence{
+		Type: tmTypes.ABCIEvidenceTypeDuplicateVote,
+		Validator: abci.Validator{
+			Address: validator.Signer.Bytes(),
+			Power:   validator.VotingPower,
+		},
+		Height: suite.ctx.BlockHeight(),
+		Time:   evidenceTime,
+	}
+}
@@ -0,0 +1,66 @@
+package slashing
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// NewQuerier returns a new sdk.Keeper instance.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParams:
+			return queryParams(ctx, req, k)
+		case types.QuerySigningInfo:
+			return querySigningInfo(ctx, req, k)
+		case types.QuerySigningInfos:
+			return querySigningInfos(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown slashing query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(k.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySigningInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySigningInfoParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	info, found := k.GetValidatorSigningInfo(ctx, params.ValidatorID)
+	if !found {
+		return nil, types.ErrNoSigningInfo(k.Codespace(), params.ValidatorID.String())
+	}
+
+	bz, err := json.Marshal(info)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	infos := k.GetAllValidatorSigningInfos(ctx)
+	if infos == nil {
+		infos = []types.ValidatorSigningInfo{}
+	}
+
+	bz, err := json.Marshal(infos)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
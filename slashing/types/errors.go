@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Slashing errors reserve 3100 ~ 3199.
+const (
+	CodeNoSigningInfo       sdk.CodeType = 3100
+	CodeNoValidator         sdk.CodeType = 3101
+	CodeInvalidSigner       sdk.CodeType = 3102
+	CodeValidatorNotJailed  sdk.CodeType = 3103
+	CodeValidatorJailed     sdk.CodeType = 3104
+	CodeValidatorTombstoned sdk.CodeType = 3105
+)
+
+// ErrNoSigningInfo is an error for validator without signing info
+func ErrNoSigningInfo(codespace sdk.CodespaceType, id string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoSigningInfo, "no signing info for validator %v", id)
+}
+
+// ErrNoValidator is an error for unknown validator
+func ErrNoValidator(codespace sdk.CodespaceType, id string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoValidator, "validator %v not found", id)
+}
+
+// ErrInvalidSigner is an error for msg not sent by signer of validator
+func ErrInvalidSigner(codespace sdk.CodespaceType, address string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSigner, "%v is not signer of validator", address)
+}
+
+// ErrValidatorNotJailed is an error for unjail of validator which is not jailed
+func ErrValidatorNotJailed(codespace sdk.CodespaceType, id string) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorNotJailed, "validator %v is not jailed", id)
+}
+
+// ErrValidatorJailed is an error for unjail before jail duration has passed
+func ErrValidatorJailed(codespace sdk.CodespaceType, id string, until string) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorJailed, "validator %v is jailed until %v", id, until)
+}
+
+// ErrValidatorTombstoned is an error for unjail of validator slashed for double signing
+func ErrValidatorTombstoned(codespace sdk.CodespaceType, id string) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorTombstoned, "validator %v is tombstoned and can't be unjailed", id)
+}
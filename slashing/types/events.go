@@ -0,0 +1,21 @@
+package types
+
+// slashing module event types
+const (
+	EventTypeSlash    = "slash"
+	EventTypeLiveness = "liveness"
+	EventTypeUnjail   = "unjail"
+
+	AttributeKeyValidatorID   = "validator-id"
+	AttributeKeySigner        = "signer"
+	AttributeKeyPower         = "power"
+	AttributeKeyReason        = "reason"
+	AttributeKeySlashedAmount = "slashed-amount"
+	AttributeKeyJailed        = "jailed"
+	AttributeKeyJailedUntil   = "jailed-until"
+	AttributeKeyMissedBlocks  = "missed-blocks"
+
+	AttributeValueDoubleSign       = "double-sign"
+	AttributeValueMissingSignature = "missing-signature"
+	AttributeValueCategory         = ModuleName
+)
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+)
+
+//
+// Gensis state
+//
+
+// GenesisState - all slashing state that must be provided at genesis
+type GenesisState struct {
+	Params       Params                  `json:"params" yaml:"params"`
+	SigningInfos []ValidatorSigningInfo  `json:"signing_infos" yaml:"signing_infos"`
+	MissedBlocks []ValidatorMissedBlocks `json:"missed_blocks" yaml:"missed_blocks"`
+}
+
+// NewGenesisState - Create a new genesis state
+func NewGenesisState(params Params, signingInfos []ValidatorSigningInfo, missedBlocks []ValidatorMissedBlocks) GenesisState {
+	return GenesisState{
+		Params:       params,
+		SigningInfos: signingInfos,
+		MissedBlocks: missedBlocks,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil)
+}
+
+// ValidateGenesis performs basic validation of slashing genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[uint64]bool, len(data.SigningInfos))
+	for _, info := range data.SigningInfos {
+		if seen[info.ValidatorID.Uint64()] {
+			return fmt.Errorf("duplicate signing info for validator %v", info.ValidatorID)
+		}
+		seen[info.ValidatorID.Uint64()] = true
+	}
+
+	for _, missed := range data.MissedBlocks {
+		if !seen[missed.ValidatorID.Uint64()] {
+			return fmt.Errorf("missed blocks of validator %v without signing info", missed.ValidatorID)
+		}
+
+		for _, offset := range missed.MissedBlocks {
+			if offset < 0 || offset >= data.Params.SignedBlocksWindow {
+				return fmt.Errorf("missed block offset %v of validator %v is out of signed blocks window", offset, missed.ValidatorID)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Unjail
+//
+
+var _ sdk.Msg = &MsgUnjail{}
+
+// MsgUnjail brings validator jailed for downtime back to validator set
+type MsgUnjail struct {
+	From hmTypes.HeimdallAddress `json:"from"`
+	ID   hmTypes.ValidatorID     `json:"id"`
+}
+
+// NewMsgUnjail creates new unjail msg
+func NewMsgUnjail(from hmTypes.HeimdallAddress, id hmTypes.ValidatorID) MsgUnjail {
+	return MsgUnjail{
+		From: from,
+		ID:   id,
+	}
+}
+
+// Type returns message type
+func (msg MsgUnjail) Type() string {
+	return "unjail"
+}
+
+// Route returns message route
+func (msg MsgUnjail) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSignBytes returns sign bytes
+func (msg MsgUnjail) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic validates the message
+func (msg MsgUnjail) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+
+	if msg.ID == 0 {
+		return ErrNoValidator(DefaultCodespace, msg.ID.String())
+	}
+
+	return nil
+}
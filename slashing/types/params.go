@@ -0,0 +1,147 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/params/subspace"
+)
+
+// Default parameter values
+const (
+	DefaultSignedBlocksWindow   int64 = 100
+	DefaultMaxEvidenceAge             = 48 * time.Hour
+	DefaultDowntimeJailDuration       = 10 * time.Minute
+)
+
+var (
+	// DefaultMinSignedPerWindow is part of signed blocks window validator must sign to stay in validator set (50%)
+	DefaultMinSignedPerWindow = sdk.NewDec(1).Quo(sdk.NewDec(2))
+
+	// DefaultSlashFractionDoubleSign is slashed part of stake for double signing (5%)
+	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
+)
+
+// Parameter keys
+var (
+	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
+	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
+	KeyMaxEvidenceAge          = []byte("MaxEvidenceAge")
+	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the slashing module.
+type Params struct {
+	SignedBlocksWindow      int64         `json:"signed_blocks_window" yaml:"signed_blocks_window"`             // number of blocks in which signed blocks of validator are counted
+	MinSignedPerWindow      sdk.Dec       `json:"min_signed_per_window" yaml:"min_signed_per_window"`           // validator signing less of window is jailed
+	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`         // time after which validator jailed for downtime can unjail
+	MaxEvidenceAge          time.Duration `json:"max_evidence_age" yaml:"max_evidence_age"`                     // evidence older than this is ignored
+	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"` // part of stake slashed for double signing
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	signedBlocksWindow int64,
+	minSignedPerWindow sdk.Dec,
+	downtimeJailDuration time.Duration,
+	maxEvidenceAge time.Duration,
+	slashFractionDoubleSign sdk.Dec,
+) Params {
+	return Params{
+		SignedBlocksWindow:      signedBlocksWindow,
+		MinSignedPerWindow:      minSignedPerWindow,
+		DowntimeJailDuration:    downtimeJailDuration,
+		MaxEvidenceAge:          maxEvidenceAge,
+		SlashFractionDoubleSign: slashFractionDoubleSign,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of slashing module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeySignedBlocksWindow, Value: &p.SignedBlocksWindow},
+		{Key: KeyMinSignedPerWindow, Value: &p.MinSignedPerWindow},
+		{Key: KeyDowntimeJailDuration, Value: &p.DowntimeJailDuration},
+		{Key: KeyMaxEvidenceAge, Value: &p.MaxEvidenceAge},
+		{Key: KeySlashFractionDoubleSign, Value: &p.SlashFractionDoubleSign},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("SignedBlocksWindow: %d\n", p.SignedBlocksWindow))
+	sb.WriteString(fmt.Sprintf("MinSignedPerWindow: %s\n", p.MinSignedPerWindow))
+	sb.WriteString(fmt.Sprintf("DowntimeJailDuration: %s\n", p.DowntimeJailDuration))
+	sb.WriteString(fmt.Sprintf("MaxEvidenceAge: %s\n", p.MaxEvidenceAge))
+	sb.WriteString(fmt.Sprintf("SlashFractionDoubleSign: %s\n", p.SlashFractionDoubleSign))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.SignedBlocksWindow <= 0 {
+		return errors.New("signed_blocks_window must be greater than zero")
+	}
+
+	if p.MinSignedPerWindow.IsNil() || p.MinSignedPerWindow.IsNegative() || p.MinSignedPerWindow.GT(sdk.OneDec()) {
+		return errors.New("min_signed_per_window must be between 0 and 1")
+	}
+
+	if p.DowntimeJailDuration < 0 {
+		return errors.New("downtime_jail_duration can't be negative")
+	}
+
+	if p.MaxEvidenceAge <= 0 {
+		return errors.New("max_evidence_age must be greater than zero")
+	}
+
+	if p.SlashFractionDoubleSign.IsNil() || p.SlashFractionDoubleSign.IsNegative() || p.SlashFractionDoubleSign.GT(sdk.OneDec()) {
+		return errors.New("slash_fraction_double_sign must be between 0 and 1")
+	}
+
+	return nil
+}
+
+// MinSignedBlocks returns number of blocks validator must sign in signed blocks window
+func (p Params) MinSignedBlocks() int64 {
+	return p.MinSignedPerWindow.MulInt64(p.SignedBlocksWindow).RoundInt64()
+}
+
+//
+// Extra functions
+//
+
+// ParamKeyTable for slashing module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		SignedBlocksWindow:      DefaultSignedBlocksWindow,
+		MinSignedPerWindow:      DefaultMinSignedPerWindow,
+		DowntimeJailDuration:    DefaultDowntimeJailDuration,
+		MaxEvidenceAge:          DefaultMaxEvidenceAge,
+		SlashFractionDoubleSign: DefaultSlashFractionDoubleSign,
+	}
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// query endpoints supported by the slashing Querier
+const (
+	QueryParams       = "params"
+	QuerySigningInfo  = "signing-info"
+	QuerySigningInfos = "signing-infos"
+)
+
+// QuerySigningInfoParams defines the params for querying signing info of validator
+type QuerySigningInfoParams struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id"`
+}
+
+// NewQuerySigningInfoParams creates a new instance of QuerySigningInfoParams.
+func NewQuerySigningInfoParams(validatorID hmTypes.ValidatorID) QuerySigningInfoParams {
+	return QuerySigningInfoParams{ValidatorID: validatorID}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ValidatorSigningInfo defines signed blocks of validator in signed blocks window
+type ValidatorSigningInfo struct {
+	ValidatorID         hmTypes.ValidatorID `json:"ID"`
+	StartHeight         int64               `json:"start_height"`          // height from which blocks of validator are counted
+	IndexOffset         int64               `json:"index_offset"`          // blocks counted since start height, offset in window is index offset mod window
+	MissedBlocksCounter int64               `json:"missed_blocks_counter"` // blocks missed in signed blocks window
+	JailedUntil         time.Time           `json:"jailed_until"`          // time until validator jailed for downtime can't unjail
+	Tombstoned          bool                `json:"tombstoned"`            // validator was slashed for double signing
+}
+
+// NewValidatorSigningInfo creates signing info for validator first seen at given height
+func NewValidatorSigningInfo(id hmTypes.ValidatorID, startHeight int64) ValidatorSigningInfo {
+	return ValidatorSigningInfo{
+		ValidatorID: id,
+		StartHeight: startHeight,
+	}
+}
+
+// String returns human readable signing info
+func (info ValidatorSigningInfo) String() string {
+	return fmt.Sprintf("ValidatorSigningInfo{%v start:%v offset:%v missed:%v jailedUntil:%v tombstoned:%v}",
+		info.ValidatorID,
+		info.StartHeight,
+		info.IndexOffset,
+		info.MissedBlocksCounter,
+		info.JailedUntil,
+		info.Tombstoned,
+	)
+}
+
+// ValidatorMissedBlocks defines offsets of blocks missed by validator in signed blocks window
+type ValidatorMissedBlocks struct {
+	ValidatorID  hmTypes.ValidatorID `json:"ID"`
+	MissedBlocks []int64             `json:"missed_blocks"`
+}
+
+// ValidatorLiveness defines missed blocks stats of validator
+type ValidatorLiveness struct {
+	SigningInfo        ValidatorSigningInfo `json:"signing_info"`
+	SignedBlocksWindow int64                `json:"signed_blocks_window"`
+	MaxMissedBlocks    int64                `json:"max_missed_blocks"` // validator missing more blocks in window is jailed
+	MissedBlocks       []int64              `json:"missed_blocks"`     // offsets of missed blocks in window
+	Jailed             bool                 `json:"jailed"`
+}
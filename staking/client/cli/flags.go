@@ -12,6 +12,12 @@ const (
 	FlagTxHash           = "tx-hash"
 	FlagLogIndex         = "log-index"
 	FlagFeeAmount        = "fee-amount"
+	FlagSnapshotHeight   = "snapshot-height"
+	FlagCheckpointNumber = "checkpoint"
+	FlagChainID          = "checkpoint-chain-id"
+	FlagFix              = "fix"
+	FlagStartBlock       = "start-block"
+	FlagEndBlock         = "end-block"
 
 	FlagStartEpoch = "start-epoch"
 	FlagEndEpoch   = "end-epoch"
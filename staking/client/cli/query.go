@@ -31,6 +31,9 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetValidatorInfo(cdc),
 			GetCurrentValSet(cdc),
+			GetValidatorMissedBlocks(cdc),
+			GetPendingSignerUpdate(cdc),
+			GetValidatorSetSnapshot(cdc),
 		)...,
 	)
 
@@ -104,3 +107,115 @@ func GetCurrentValSet(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetValidatorMissedBlocks validator missed blocks stats via id
+func GetValidatorMissedBlocks(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-missed-blocks",
+		Short: "show missed blocks stats of validator via validator id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			validatorID := viper.GetInt64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("validator ID required")
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(validatorID)))
+			if err != nil {
+				return err
+			}
+
+			// get missed blocks stats
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorMissedBlocks), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator ID here>")
+	return cmd
+}
+
+// GetPendingSignerUpdate signer rotation of validator waiting for next checkpoint ack via id
+func GetPendingSignerUpdate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-signer-update",
+		Short: "show signer rotation of validator waiting for next checkpoint ack via validator id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			validatorID := viper.GetInt64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("validator ID required")
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(validatorID)))
+			if err != nil {
+				return err
+			}
+
+			// get pending signer update
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingSignerUpdate), queryParams)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no pending signer update found")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator ID here>")
+	return cmd
+}
+
+// GetValidatorSetSnapshot historical validator set via height or checkpoint number
+func GetValidatorSetSnapshot(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-snapshot",
+		Short: "show validator set at height or validator set which signed checkpoint",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			checkpointNumber := viper.GetUint64(FlagCheckpointNumber)
+			snapshotHeight := viper.GetInt64(FlagSnapshotHeight)
+			if checkpointNumber == 0 && snapshotHeight == 0 {
+				return fmt.Errorf("height or checkpoint number required")
+			}
+
+			var queryParams []byte
+			var err error
+			var t string
+			if checkpointNumber != 0 {
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetByCheckpointParams(viper.GetString(FlagChainID), checkpointNumber))
+				t = types.QueryValidatorSetByCheckpoint
+			} else {
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetByHeightParams(snapshotHeight))
+				t = types.QueryValidatorSetByHeight
+			}
+			if err != nil {
+				return err
+			}
+
+			// get validator set snapshot
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, t), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagSnapshotHeight, 0, "--snapshot-height=<heimdall height here>")
+	cmd.Flags().Uint64(FlagCheckpointNumber, 0, "--checkpoint=<checkpoint number here>")
+	cmd.Flags().String(FlagChainID, "", "--checkpoint-chain-id=<checkpoint chain id here>")
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -39,6 +40,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 			SendValidatorUpdateTx(cdc),
 			SendValidatorExitTx(cdc),
 			SendValidatorStakeUpdateTx(cdc),
+			ReconcileValidatorsTx(cdc),
 		)...,
 	)
 	return txCmd
@@ -289,3 +291,161 @@ func SendValidatorStakeUpdateTx(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// ReconcileValidatorsTx compares validators with staking contracts and optionally sends msgs replaying missed events
+func ReconcileValidatorsTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile-validators",
+		Short: "Compare power, signer and deactivation epoch of validators with staking contracts, --fix sends staking msgs replaying missed events",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			// get all validators
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllValidators), nil)
+			if err != nil {
+				return err
+			}
+
+			var validators []*hmTypes.Validator
+			if err := json.Unmarshal(res, &validators); err != nil {
+				return err
+			}
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			configParams, err := util.GetConfigManagerParams(cliCtx)
+			if err != nil {
+				return err
+			}
+			chainParams := configParams.ChainParams
+
+			diffs, err := types.ReconcileValidators(
+				&contractCallerObj,
+				chainParams.StakingInfoAddress.EthAddress(),
+				chainParams.StakingManagerAddress.EthAddress(),
+				validators,
+			)
+			if err != nil {
+				return err
+			}
+
+			// signer rotations staged until next checkpoint ack are not missed events
+			pendingUpdates, err := getPendingSignerUpdates(cliCtx, diffs)
+			if err != nil {
+				return err
+			}
+			diffs = types.SkipPendingSignerDiffs(diffs, pendingUpdates)
+
+			if len(diffs) == 0 {
+				fmt.Println("Validators are in sync with staking contracts")
+				return nil
+			}
+
+			for _, diff := range diffs {
+				fmt.Println(diff.String())
+			}
+
+			if !viper.GetBool(FlagFix) {
+				return nil
+			}
+
+			startBlock := viper.GetUint64(FlagStartBlock)
+			if startBlock == 0 {
+				startBlock = helper.GetConfig().StakingReconcileStartBlock
+			}
+
+			// search events until latest confirmed main chain block by default
+			endBlock := viper.GetUint64(FlagEndBlock)
+			if endBlock == 0 {
+				latest, err := contractCallerObj.GetMainChainBlock(nil)
+				if err != nil {
+					return err
+				}
+
+				confirmations := helper.GetConfig().MainchainConfirmations
+				if latest.Number.Uint64() < confirmations {
+					return errors.New("No confirmed main chain blocks to search staking events")
+				}
+				endBlock = latest.Number.Uint64() - confirmations
+			}
+
+			if startBlock > endBlock {
+				return fmt.Errorf("Start block %v is after end block %v", startBlock, endBlock)
+			}
+
+			correctingMsgs, err := types.GetCorrectingMsgs(
+				&contractCallerObj,
+				chainParams.StakingInfoAddress.EthAddress(),
+				proposer,
+				diffs,
+				startBlock,
+				endBlock,
+			)
+			if err != nil {
+				return err
+			}
+
+			if len(correctingMsgs) == 0 {
+				return errors.New("No staking events found to replay")
+			}
+
+			msgs := make([]sdk.Msg, 0, len(correctingMsgs))
+			for _, correctingMsg := range correctingMsgs {
+				fmt.Println("Replaying", correctingMsg.Msg.Type(), "for", correctingMsg.Diff.String(), "txHash", correctingMsg.TxHash.String(), "logIndex", correctingMsg.LogIndex)
+				msgs = append(msgs, correctingMsg.Msg)
+			}
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, msgs)
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Bool(FlagFix, false, "--fix=<send staking msgs replaying missed events>")
+	cmd.Flags().Uint64(FlagStartBlock, 0, "--start-block=<main chain block to search staking events from, defaults to staking_reconcile_start_block>")
+	cmd.Flags().Uint64(FlagEndBlock, 0, "--end-block=<main chain block to search staking events until, defaults to latest confirmed block>")
+
+	return cmd
+}
+
+// getPendingSignerUpdates queries signer rotations staged for validators with signer diffs
+func getPendingSignerUpdates(cliCtx context.CLIContext, diffs []types.ValidatorDiff) ([]types.PendingSignerUpdate, error) {
+	updates := make([]types.PendingSignerUpdate, 0)
+	for _, diff := range diffs {
+		if diff.Field != types.DiffFieldSigner {
+			continue
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(diff.ID))
+		if err != nil {
+			return nil, err
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingSignerUpdate), queryParams)
+		if err != nil {
+			return nil, err
+		}
+
+		// no signer rotation staged for validator
+		if len(res) == 0 {
+			continue
+		}
+
+		var update types.PendingSignerUpdate
+		if err := json.Unmarshal(res, &update); err != nil {
+			return nil, err
+		}
+		updates = append(updates, update)
+	}
+
+	return updates, nil
+}
@@ -29,10 +29,30 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/validator/{id}",
 		validatorByIDHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator/{id}/missed-blocks",
+		validatorMissedBlocksHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator/{id}/pending-signer-update",
+		pendingSignerUpdateHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validators",
+		allValidatorsHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/validator-set",
 		validatorSetHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator-set/{height}",
+		validatorSetByHeightHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator-set/checkpoint/{number}",
+		validatorSetByCheckpointHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/proposer/{times}",
 		proposerHandlerFn(cliCtx),
@@ -196,6 +216,191 @@ func validatorByIDHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// Returns missed blocks stats of validator by val ID
+func validatorMissedBlocksHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(id)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorMissedBlocks), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching validator missed blocks", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no validator found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No validator found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns signer rotation of validator which waits for next checkpoint ack
+func pendingSignerUpdateHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(id)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingSignerUpdate), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching pending signer update", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no signer update is pending
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No pending signer update found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns validator set snapshot in effect at height
+func validatorSetByHeightHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get height
+		snapshotHeight, ok := rest.ParseInt64OrReturnBadRequest(w, vars["height"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetByHeightParams(snapshotHeight))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetByHeight), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching validator set snapshot", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no snapshot found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No validator set snapshot found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns validator set snapshot which signed checkpoint, chain_id query param selects checkpoint chain
+func validatorSetByCheckpointHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get checkpoint number
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetByCheckpointParams(r.URL.Query().Get("chain_id"), number))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetByCheckpoint), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching checkpoint validator set snapshot", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no snapshot found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No validator set snapshot found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// get all validators in state, including inactive ones
+func allValidatorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllValidators), nil)
+		if err != nil {
+			RestLogger.Error("Error while fetching all validators", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // get current validator set
 func validatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -46,6 +46,26 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	for _, sequence := range data.StakingSequences {
 		keeper.SetStakingSequence(ctx, sequence)
 	}
+
+	// signer rotations staged until next checkpoint ack
+	for _, update := range data.PendingSignerUpdates {
+		if err := keeper.SetPendingSignerUpdate(ctx, update); err != nil {
+			panic(err)
+		}
+	}
+
+	// validator set history, snapshots are added before checkpoints signed by them
+	for _, snapshot := range data.ValidatorSetSnapshots {
+		if err := keeper.SetValidatorSetSnapshot(ctx, snapshot); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, checkpointValidatorSet := range data.CheckpointValidatorSets {
+		if err := keeper.SetCheckpointValidatorSet(ctx, checkpointValidatorSet.ChainID, checkpointValidatorSet.CheckpointNumber, checkpointValidatorSet.Height); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -56,5 +76,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		keeper.GetValidatorSet(ctx),
 		keeper.GetAllDividendAccounts(ctx),
 		keeper.GetStakingSequences(ctx),
+		keeper.GetAllPendingSignerUpdates(ctx),
+		keeper.GetAllValidatorSetSnapshots(ctx),
+		keeper.GetAllCheckpointValidatorSets(ctx),
 	)
 }
@@ -0,0 +1,98 @@
+package staking_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	cmn "github.com/maticnetwork/heimdall/test"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+func TestExportImportSignerUpdatesAndSnapshots(t *testing.T) {
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	valSet := cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+
+	update := stakingTypes.NewPendingSignerUpdate(valSet.Validators[0].ID, types.NewPubKey(secp256k1.GenPrivKey().PubKey().Bytes()), 2)
+	require.NoError(t, keeper.SetPendingSignerUpdate(ctx, update))
+
+	// validator set changes at height 20, checkpoints of two chains are signed by both sets
+	changed := keeper.GetValidatorSet(ctx)
+	removed := changed.Validators[1].Copy()
+	removed.VotingPower = 0
+	require.NoError(t, changed.UpdateWithChangeSet([]*types.Validator{removed}))
+	require.NoError(t, keeper.UpdateValidatorSetInStore(ctx.WithBlockHeight(20), changed))
+	require.NoError(t, keeper.SetCheckpointValidatorSet(ctx, "15001", 1, 10))
+	require.NoError(t, keeper.SetCheckpointValidatorSet(ctx, "80001", 1, 25))
+
+	genesis := staking.ExportGenesis(ctx, keeper)
+	require.NoError(t, stakingTypes.ValidateGenesis(genesis))
+	require.Equal(t, []stakingTypes.PendingSignerUpdate{update}, genesis.PendingSignerUpdates)
+	require.Len(t, genesis.ValidatorSetSnapshots, 2)
+	require.Equal(t, []stakingTypes.CheckpointValidatorSet{
+		{ChainID: "15001", CheckpointNumber: 1, Height: 1},
+		{ChainID: "80001", CheckpointNumber: 1, Height: 20},
+	}, genesis.CheckpointValidatorSets)
+
+	// imported state exports same signer updates and validator set history
+	importedCtx, importedKeeper, _ := cmn.CreateTestInput(t, false)
+	staking.InitGenesis(importedCtx, importedKeeper, genesis)
+	imported := staking.ExportGenesis(importedCtx, importedKeeper)
+	require.Equal(t, genesis.PendingSignerUpdates, imported.PendingSignerUpdates)
+	require.Equal(t, genesis.ValidatorSetSnapshots, imported.ValidatorSetSnapshots)
+	require.Equal(t, genesis.CheckpointValidatorSets, imported.CheckpointValidatorSets)
+
+	snapshot, ok := importedKeeper.GetValidatorSetSnapshotByCheckpoint(importedCtx, "80001", 1)
+	require.True(t, ok)
+	require.Len(t, snapshot.Validators, 3)
+}
+
+func TestValidateGenesisSignerUpdatesAndSnapshots(t *testing.T) {
+	pubKey := types.NewPubKey(secp256k1.GenPrivKey().PubKey().Bytes())
+	update := stakingTypes.NewPendingSignerUpdate(types.NewValidatorID(1), pubKey, 1)
+	snapshot := stakingTypes.ValidatorSetSnapshot{
+		Height:           10,
+		Validators:       []stakingTypes.SnapshotValidator{{ID: types.NewValidatorID(1), Signer: update.NewSigner, VotingPower: 10}},
+		TotalVotingPower: 10,
+	}
+
+	valid := stakingTypes.DefaultGenesisState()
+	valid.PendingSignerUpdates = []stakingTypes.PendingSignerUpdate{update}
+	valid.ValidatorSetSnapshots = []stakingTypes.ValidatorSetSnapshot{snapshot}
+	valid.CheckpointValidatorSets = []stakingTypes.CheckpointValidatorSet{{ChainID: "15001", CheckpointNumber: 1, Height: 10}}
+	require.NoError(t, stakingTypes.ValidateGenesis(valid))
+
+	testCases := []struct {
+		name   string
+		modify func(genesis *stakingTypes.GenesisState)
+	}{
+		{"signer not matching pubkey", func(genesis *stakingTypes.GenesisState) {
+			genesis.PendingSignerUpdates = []stakingTypes.PendingSignerUpdate{{ValidatorID: update.ValidatorID, NewSigner: types.HexToHeimdallAddress("0x1"), NewPubKey: pubKey}}
+		}},
+		{"duplicate signer update", func(genesis *stakingTypes.GenesisState) {
+			genesis.PendingSignerUpdates = []stakingTypes.PendingSignerUpdate{update, update}
+		}},
+		{"wrong total voting power", func(genesis *stakingTypes.GenesisState) {
+			invalid := snapshot
+			invalid.TotalVotingPower = 20
+			genesis.ValidatorSetSnapshots = []stakingTypes.ValidatorSetSnapshot{invalid}
+		}},
+		{"duplicate snapshot height", func(genesis *stakingTypes.GenesisState) {
+			genesis.ValidatorSetSnapshots = []stakingTypes.ValidatorSetSnapshot{snapshot, snapshot}
+		}},
+		{"checkpoint without snapshot", func(genesis *stakingTypes.GenesisState) {
+			genesis.CheckpointValidatorSets = []stakingTypes.CheckpointValidatorSet{{ChainID: "15001", CheckpointNumber: 1, Height: 15}}
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesis := valid
+			tc.modify(&genesis)
+			require.Error(t, stakingTypes.ValidateGenesis(genesis))
+		})
+	}
+}
@@ -8,7 +8,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/staking/types"
@@ -43,6 +42,7 @@ func HandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keepe
 	chainParams := params.ChainParams
 
 	// get main tx receipt
+	// TODO move to side-tx handler, receipt is fetched in DeliverTx (see sidetx package)
 	receipt, err := contractCaller.GetConfirmedTxReceipt(ctx.BlockTime(), msg.TxHash.EthHash(), params.TxConfirmationTime)
 	if err != nil || receipt == nil {
 		return hmCommon.ErrWaitForConfirmation(k.Codespace(), params.TxConfirmationTime).Result()
@@ -161,6 +161,7 @@ func HandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, c
 	chainParams := params.ChainParams
 
 	// get main tx receipt
+	// TODO move to side-tx handler, receipt is fetched in DeliverTx (see sidetx package)
 	receipt, err := contractCaller.GetConfirmedTxReceipt(ctx.BlockTime(), msg.TxHash.EthHash(), params.TxConfirmationTime)
 	if err != nil || receipt == nil {
 		return hmCommon.ErrWaitForConfirmation(k.Codespace(), params.TxConfirmationTime).Result()
@@ -237,6 +238,7 @@ func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper,
 	params := k.chainKeeper.GetParams(ctx)
 	chainParams := params.ChainParams
 	// get main tx receipt
+	// TODO move to side-tx handler, receipt is fetched in DeliverTx (see sidetx package)
 	receipt, err := contractCaller.GetConfirmedTxReceipt(ctx.BlockTime(), msg.TxHash.EthHash(), params.TxConfirmationTime)
 	if err != nil || receipt == nil {
 		return hmCommon.ErrWaitForConfirmation(k.Codespace(), params.TxConfirmationTime).Result()
@@ -273,8 +275,6 @@ func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper,
 		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
 		return hmCommon.ErrNoValidator(k.Codespace()).Result()
 	}
-	oldValidator := validator.Copy()
-
 	// sequence id
 
 	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
@@ -289,39 +289,21 @@ func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper,
 	// update last udpated
 	validator.LastUpdated = sequence.String()
 
-	// check if we are actually updating signer
-	if !bytes.Equal(newSigner.Bytes(), validator.Signer.Bytes()) {
-		// Update signer in prev Validator
-		validator.Signer = hmTypes.HeimdallAddress(newSigner)
-		validator.PubKey = newPubKey
-		k.Logger(ctx).Debug("Updating new signer", "signer", newSigner.String(), "oldSigner", oldValidator.Signer.String(), "validatorID", msg.ID)
-	}
-
-	k.Logger(ctx).Debug("Removing old validator", "validator", oldValidator.String())
-
-	// remove old validator from HM
-	oldValidator.EndEpoch = k.moduleCommunicator.GetACKCount(ctx)
-
-	// remove old validator from TM
-	oldValidator.VotingPower = 0
-	// updated last
-	oldValidator.LastUpdated = sequence.String()
-
-	// save old validator
-	if err := k.AddValidator(ctx, *oldValidator); err != nil {
-		k.Logger(ctx).Error("Unable to update signer", "error", err, "validatorId", validator.ID)
-		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
-	}
-
-	// adding new validator
-	k.Logger(ctx).Debug("Adding new validator", "validator", validator.String())
-
-	// save validator
+	// save validator, old signer stays in charge until next ack
 	err = k.AddValidator(ctx, validator)
 	if err != nil {
 		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
 		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
 	}
+
+	// stage new signer, it replaces any earlier rotation of validator which is not applied yet
+	update := types.NewPendingSignerUpdate(validator.ID, newPubKey, k.moduleCommunicator.GetACKCount(ctx)+1)
+	if err := k.SetPendingSignerUpdate(ctx, update); err != nil {
+		k.Logger(ctx).Error("Unable to stage signer update", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	}
+	k.Logger(ctx).Debug("Staged new signer", "signer", newSigner.String(), "oldSigner", validator.Signer.String(), "validatorID", msg.ID, "effectiveAckCount", update.EffectiveAckCount)
+
 	// save staking sequence
 	k.SetStakingSequence(ctx, sequence.String())
 
@@ -330,26 +312,12 @@ func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper,
 			types.EventTypeSignerUpdate,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
+			sdk.NewAttribute(types.AttributeKeySigner, update.NewSigner.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveAckCount, strconv.FormatUint(update.EffectiveAckCount, 10)),
 			sdk.NewAttribute(types.AttributeKeyUpdatedAt, validator.LastUpdated),
 		),
 	})
 
-	//
-	// Move heimdall fee to new signer
-	//
-
-	// check if fee is already withdrawn
-	coins := k.moduleCommunicator.GetCoins(ctx, oldValidator.Signer)
-	maticBalance := coins.AmountOf(authTypes.FeeToken)
-	if !maticBalance.IsZero() {
-		k.Logger(ctx).Info("Transferring fee", "from", oldValidator.Signer.String(), "to", validator.Signer.String(), "balance", maticBalance.String())
-		maticCoins := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: maticBalance}}
-		if err := k.moduleCommunicator.SendCoins(ctx, oldValidator.Signer, validator.Signer, maticCoins); err != nil {
-			k.Logger(ctx).Info("Error while transferring fee", "from", oldValidator.Signer.String(), "to", validator.Signer.String(), "balance", maticBalance.String())
-			return err.Result()
-		}
-	}
-
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
@@ -364,6 +332,7 @@ func HandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keepe
 	chainParams := params.ChainParams
 
 	// get main tx receipt
+	// TODO move to side-tx handler, receipt is fetched in DeliverTx (see sidetx package)
 	receipt, err := contractCaller.GetConfirmedTxReceipt(ctx.BlockTime(), msg.TxHash.EthHash(), params.TxConfirmationTime)
 	if err != nil || receipt == nil {
 		return hmCommon.ErrWaitForConfirmation(k.Codespace(), params.TxConfirmationTime).Result()
@@ -5,8 +5,7 @@ import (
 	"testing"
 
 	ethTypes "github.com/maticnetwork/bor/core/types"
-	"github.com/maticnetwork/heimdall/contracts/stakemanager"
-	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	"github.com/maticnetwork/heimdall/staking"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
@@ -16,6 +15,18 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// tokens returns amount of given tokens with 18 decimals
+func tokens(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+}
+
+// mockConfirmedReceipt mocks confirmed receipt of staking tx on mainchain
+func mockConfirmedReceipt(contractCallerObj *mocks.IContractCaller, txHash types.HeimdallHash, blockNumber int64) *ethTypes.Receipt {
+	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(blockNumber)}
+	contractCallerObj.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), mock.Anything).Return(txreceipt, nil)
+	return txreceipt
+}
+
 func TestHandleMsgValidatorJoin(t *testing.T) {
 	contractCallerObj := mocks.IContractCaller{}
 	ctx, keeper, _ := cmn.CreateTestInput(t, false)
@@ -23,11 +34,19 @@ func TestHandleMsgValidatorJoin(t *testing.T) {
 	// select first validator from slice
 	mockVal := mockVals[0]
 	t.Log("Inserting ===>", "Validator", mockVal.Signer.String())
-	contractCallerObj.On("GetValidatorInfo", mock.Anything).Return(mockVal, nil)
-	// insert new validator
-	// msgTxHash := types.HeimdallHash("123")
+
 	msgTxHash := types.HexToHeimdallHash("123")
-	contractCallerObj.On("IsTxConfirmed", msgTxHash.EthHash()).Return(true)
+	txreceipt := mockConfirmedReceipt(&contractCallerObj, msgTxHash, 10)
+	stakedEvent := &stakinginfo.StakinginfoStaked{
+		Signer:          mockVal.Signer.EthAddress(),
+		ValidatorId:     new(big.Int).SetUint64(mockVal.ID.Uint64()),
+		ActivationEpoch: big.NewInt(0),
+		Amount:          tokens(mockVal.VotingPower),
+		Total:           tokens(mockVal.VotingPower),
+		SignerPubkey:    mockVal.PubKey.Bytes()[1:],
+	}
+	contractCallerObj.On("DecodeValidatorJoinEvent", mock.Anything, txreceipt, uint64(0)).Return(stakedEvent, nil)
+
 	msgValJoin := stakingTypes.NewMsgValidatorJoin(mockVal.Signer, uint64(mockVal.ID), mockVal.PubKey, msgTxHash, 0)
 	t.Log("msg val join", msgValJoin)
 	got := staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper, &contractCallerObj)
@@ -36,6 +55,7 @@ func TestHandleMsgValidatorJoin(t *testing.T) {
 	storedVal, err := keeper.GetValidatorInfo(ctx, mockVal.Signer.Bytes())
 	require.Empty(t, err, "Unable to get validator info from val address,ValAddr:%v Error:%v ", mockVal.Signer.String(), err)
 	require.Equal(t, mockVal.Signer, storedVal.Signer, "Signer address should match")
+	require.Equal(t, mockVal.VotingPower, storedVal.VotingPower, "Voting power should match staked amount")
 	t.Log("Stored ===>", "Validator", storedVal.String())
 	// signer to validator mapping should exist properly
 	storedSigner, found := keeper.GetSignerFromValidatorID(ctx, mockVal.ID)
@@ -45,19 +65,18 @@ func TestHandleMsgValidatorJoin(t *testing.T) {
 	// insert validator again
 	got = staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper, &contractCallerObj)
 	require.True(t, !got.IsOK(), "expected validator join to be not-ok, got %v", got)
-	// check if new validator gets added in validator set
 }
 
-func TestHandleMsgValidatorUpdate(t *testing.T) {
+func TestHandleMsgSignerUpdate(t *testing.T) {
 	contractCallerObj := mocks.IContractCaller{}
-	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	ctx, keeper, ck := cmn.CreateTestInput(t, false)
 
 	// pass 0 as time alive to generate non de-activated validators
 	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
 	oldValSet := keeper.GetValidatorSet(ctx)
 
-	// vals := oldValSet.(*Validators)
-	oldSigner := oldValSet.Validators[0]
+	// rotation of current proposer is deferred, see TestApplyPendingSignerUpdatesDeferred
+	oldSigner := nonProposer(oldValSet)
 	newSigner := cmn.GenRandomVal(1, 0, 10, 10, false, 1)
 	newSigner[0].ID = oldSigner.ID
 	newSigner[0].VotingPower = oldSigner.VotingPower
@@ -65,38 +84,44 @@ func TestHandleMsgValidatorUpdate(t *testing.T) {
 	// gen msg
 	msgTxHash := types.HexToHeimdallHash("123")
 	msg := stakingTypes.NewMsgSignerUpdate(newSigner[0].Signer, uint64(newSigner[0].ID), newSigner[0].PubKey, msgTxHash, 0)
-	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash()).Return(txreceipt, nil)
-	signerUpdateEvent := &stakemanager.StakemanagerSignerChange{
-		ValidatorId: new(big.Int).SetUint64(oldSigner.ID.Uint64()),
-		OldSigner:   oldSigner.Signer.EthAddress(),
-		NewSigner:   newSigner[0].Signer.EthAddress(),
+	txreceipt := mockConfirmedReceipt(&contractCallerObj, msgTxHash, 10)
+	signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
+		ValidatorId:  new(big.Int).SetUint64(oldSigner.ID.Uint64()),
+		OldSigner:    oldSigner.Signer.EthAddress(),
+		NewSigner:    newSigner[0].Signer.EthAddress(),
+		SignerPubkey: newSigner[0].PubKey.Bytes()[1:],
 	}
-	contractCallerObj.On("DecodeSignerUpdateEvent", txreceipt, uint64(0)).Return(signerUpdateEvent, nil)
+	contractCallerObj.On("DecodeSignerUpdateEvent", mock.Anything, txreceipt, uint64(0)).Return(signerUpdateEvent, nil)
 
 	got := staking.HandleMsgSignerUpdate(ctx, msg, keeper, &contractCallerObj)
 	require.True(t, got.IsOK(), "expected validator update to be ok, got %v", got)
 	newValidators := keeper.GetCurrentValidators(ctx)
 	require.Equal(t, len(oldValSet.Validators), len(newValidators), "Number of current validators should be equal")
-	// apply updates
-	// helper.UpdateValidators(
-	// 	&oldValSet,                          // pointer to current validator set -- UpdateValidators will modify it
-	// 	keeper.GetAllValidators(ctx),        // All validators
-	// 	checkpointkeeper.GetACKCount(ctx)+1, // ack count
-	// )
-	setUpdates := helper.GetUpdatedValidators(&oldValSet, keeper.GetAllValidators(ctx), 5)
-	oldValSet.UpdateWithChangeSet(setUpdates)
-	_ = keeper.UpdateValidatorSetInStore(ctx, oldValSet)
-
-	ValFrmID, ok := keeper.GetValidatorFromValID(ctx, oldSigner.ID)
-	require.True(t, ok, "new signer should be found, got %v", ok)
-	require.Equal(t, ValFrmID.Signer.Bytes(), newSigner[0].Signer.Bytes(), "New Signer should be mapped to old validator ID")
-	require.Equal(t, ValFrmID.VotingPower, oldSigner.VotingPower, "VotingPower of new signer %v should be equal to old signer %v", ValFrmID.VotingPower, oldSigner.VotingPower)
-	removedVal, err := keeper.GetValidatorInfo(ctx, oldSigner.Signer.Bytes())
-	require.Empty(t, err, "deleted validator should be found, got %v", err)
-	require.Equal(t, removedVal.VotingPower, int64(0), "removed validator VotingPower should be zero")
-	t.Log("Deleted validator ===>", "Validator", removedVal.String())
 
+	// old signer stays in charge until new signer is applied with next ack
+	val, ok := keeper.GetValidatorFromValID(ctx, oldSigner.ID)
+	require.True(t, ok)
+	require.Equal(t, oldSigner.Signer, val.Signer)
+
+	update, ok := keeper.GetPendingSignerUpdate(ctx, oldSigner.ID)
+	require.True(t, ok, "signer update should be pending")
+	require.Equal(t, newSigner[0].Signer, update.NewSigner)
+
+	// same tx can't be replayed
+	got = staking.HandleMsgSignerUpdate(ctx, msg, keeper, &contractCallerObj)
+	require.False(t, got.IsOK(), "expected replayed signer update to be not ok")
+
+	// new signer takes over with next ack
+	ck.UpdateACKCountWithValue(ctx, ck.GetACKCount(ctx)+1)
+	keeper.ApplyPendingSignerUpdates(ctx)
+
+	val, ok = keeper.GetValidatorFromValID(ctx, oldSigner.ID)
+	require.True(t, ok)
+	require.Equal(t, newSigner[0].Signer, val.Signer)
+	require.Equal(t, newSigner[0].PubKey, val.PubKey)
+
+	_, ok = keeper.GetPendingSignerUpdate(ctx, oldSigner.ID)
+	require.False(t, ok, "signer update should be applied")
 }
 
 func TestHandleMsgValidatorExit(t *testing.T) {
@@ -106,16 +131,21 @@ func TestHandleMsgValidatorExit(t *testing.T) {
 	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
 	validators := keeper.GetCurrentValidators(ctx)
 	msgTxHash := types.HexToHeimdallHash("123")
-	contractCallerObj.On("IsTxConfirmed", msgTxHash.EthHash()).Return(true)
+	txreceipt := mockConfirmedReceipt(&contractCallerObj, msgTxHash, 10)
+	unstakeEvent := &stakinginfo.StakinginfoUnstakeInit{
+		User:              validators[0].Signer.EthAddress(),
+		ValidatorId:       new(big.Int).SetUint64(validators[0].ID.Uint64()),
+		DeactivationEpoch: big.NewInt(10),
+		Amount:            tokens(validators[0].VotingPower),
+	}
+	contractCallerObj.On("DecodeValidatorExitEvent", mock.Anything, txreceipt, uint64(0)).Return(unstakeEvent, nil)
 
-	validators[0].EndEpoch = 10
 	msg := stakingTypes.NewMsgValidatorExit(validators[0].Signer, uint64(validators[0].ID), msgTxHash, 0)
-	contractCallerObj.On("GetValidatorInfo", validators[0].ID).Return(validators[0], nil)
 	got := staking.HandleMsgValidatorExit(ctx, msg, keeper, &contractCallerObj)
 	require.True(t, got.IsOK(), "expected validator exit to be ok, got %v", got)
 	updatedValInfo, err := keeper.GetValidatorInfo(ctx, validators[0].Signer.Bytes())
 	require.Empty(t, err, "Unable to get validator info from val address,ValAddr:%v Error:%v ", validators[0].Signer.String(), err)
-	require.Equal(t, updatedValInfo.EndEpoch, validators[0].EndEpoch, "deactivation epoch should be set correctly")
+	require.Equal(t, unstakeEvent.DeactivationEpoch.Uint64(), updatedValInfo.EndEpoch, "deactivation epoch should be set correctly")
 	_, found := keeper.GetValidatorFromValID(ctx, validators[0].ID)
 	require.True(t, found, "Validator should be present even after deactivation")
 	got = staking.HandleMsgValidatorExit(ctx, msg, keeper, &contractCallerObj)
@@ -142,20 +172,16 @@ func TestHandleMsgStakeUpdate(t *testing.T) {
 	// gen msg
 	msgTxHash := types.HexToHeimdallHash("123")
 	msg := stakingTypes.NewMsgStakeUpdate(oldVal.Signer, oldVal.ID.Uint64(), msgTxHash, 0)
-	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash()).Return(txreceipt, nil)
-	stakeUpdateEvent := &stakemanager.StakemanagerStakeUpdate{
+	txreceipt := mockConfirmedReceipt(&contractCallerObj, msgTxHash, 10)
+	stakeUpdateEvent := &stakinginfo.StakinginfoStakeUpdate{
 		ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
-		OldAmount:   new(big.Int).SetInt64(oldVal.VotingPower),
-		NewAmount:   new(big.Int).SetInt64(2000000000000000000),
+		NewAmount:   tokens(2),
 	}
-
-	contractCallerObj.On("DecodeValidatorStakeUpdateEvent", txreceipt, uint64(0)).Return(stakeUpdateEvent, nil)
+	contractCallerObj.On("DecodeValidatorStakeUpdateEvent", mock.Anything, txreceipt, uint64(0)).Return(stakeUpdateEvent, nil)
 
 	got := staking.HandleMsgStakeUpdate(ctx, msg, keeper, &contractCallerObj)
 	require.True(t, got.IsOK(), "expected validator stake update to be ok, got %v", got)
 	updatedVal, err := keeper.GetValidatorInfo(ctx, oldVal.Signer.Bytes())
 	require.Empty(t, err, "unable to fetch validator info %v-", err)
-	require.Equal(t, stakeUpdateEvent.NewAmount.Int64(), updatedVal.VotingPower, "Validator VotingPower should be updated to %v", stakeUpdateEvent.NewAmount.Uint64())
-
+	require.Equal(t, int64(2), updatedVal.VotingPower, "Validator VotingPower should be updated to staked tokens")
 }
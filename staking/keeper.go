@@ -1,6 +1,8 @@
 package staking
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"math/big"
@@ -10,9 +12,11 @@ import (
 	"github.com/maticnetwork/bor/common"
 	"github.com/tendermint/tendermint/libs/log"
 
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/params/subspace"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
 	"github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
@@ -26,6 +30,10 @@ var (
 	PrevDividendAccountMapKey = []byte{0x41} // store for dividend accounts before checkpoint ack.
 	DividendAccountMapKey     = []byte{0x42} // prefix for each key for Dividend Account Map
 	StakingSequenceKey        = []byte{0x24} // prefix for each key for staking sequence map
+	ValidatorSetChangeKey     = []byte{0x25} // Key to store flag for validator set change outside of txs (eg. jailing)
+	ValidatorSetSnapshotKey   = []byte{0x26} // prefix for each key to validator set snapshot by height
+	CheckpointValidatorSetKey = []byte{0x27} // prefix for each key to height of validator set snapshot which signed checkpoint
+	PendingSignerUpdateKey    = []byte{0x28} // prefix for each key to signer rotation staged until next ack
 )
 
 // ModuleCommunicator manages different module interaction
@@ -34,6 +42,8 @@ type ModuleCommunicator interface {
 	SetCoins(ctx sdk.Context, addr hmTypes.HeimdallAddress, amt sdk.Coins) sdk.Error
 	GetCoins(ctx sdk.Context, addr hmTypes.HeimdallAddress) sdk.Coins
 	SendCoins(ctx sdk.Context, from hmTypes.HeimdallAddress, to hmTypes.HeimdallAddress, amt sdk.Coins) sdk.Error
+	GetValidatorLiveness(ctx sdk.Context, valID hmTypes.ValidatorID) (slashingTypes.ValidatorLiveness, bool)
+	GetBufferedCheckpointProposers(ctx sdk.Context) []hmTypes.HeimdallAddress
 }
 
 // Keeper stores all related data
@@ -266,6 +276,171 @@ func (k *Keeper) UpdateSigner(ctx sdk.Context, newSigner hmTypes.HeimdallAddress
 	return nil
 }
 
+// GetPendingSignerUpdateKey returns key for signer rotation of validator
+func GetPendingSignerUpdateKey(valID hmTypes.ValidatorID) []byte {
+	return append(PendingSignerUpdateKey, valID.Bytes()...)
+}
+
+// SetPendingSignerUpdate stages signer rotation of validator, replacing earlier one
+func (k *Keeper) SetPendingSignerUpdate(ctx sdk.Context, update types.PendingSignerUpdate) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(update)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetPendingSignerUpdateKey(update.ValidatorID), bz)
+	return nil
+}
+
+// GetPendingSignerUpdate returns signer rotation staged for validator
+func (k *Keeper) GetPendingSignerUpdate(ctx sdk.Context, valID hmTypes.ValidatorID) (update types.PendingSignerUpdate, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetPendingSignerUpdateKey(valID))
+	if bz == nil {
+		return update, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &update); err != nil {
+		k.Logger(ctx).Error("Error while unmarshalling pending signer update", "validatorID", valID, "error", err)
+		return update, false
+	}
+
+	return update, true
+}
+
+// GetAllPendingSignerUpdates returns all staged signer rotations
+func (k *Keeper) GetAllPendingSignerUpdates(ctx sdk.Context) (updates []types.PendingSignerUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PendingSignerUpdateKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var update types.PendingSignerUpdate
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &update); err != nil {
+			k.Logger(ctx).Error("Error while unmarshalling pending signer update", "error", err)
+			continue
+		}
+		updates = append(updates, update)
+	}
+
+	return updates
+}
+
+// RemovePendingSignerUpdate removes staged signer rotation of validator
+func (k *Keeper) RemovePendingSignerUpdate(ctx sdk.Context, valID hmTypes.ValidatorID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetPendingSignerUpdateKey(valID))
+}
+
+// GetPendingSignerUpdateBySigner returns staged signer rotation with address as new signer
+func (k *Keeper) GetPendingSignerUpdateBySigner(ctx sdk.Context, address []byte) (update types.PendingSignerUpdate, ok bool) {
+	for _, update := range k.GetAllPendingSignerUpdates(ctx) {
+		if bytes.Equal(update.NewSigner.Bytes(), address) {
+			return update, true
+		}
+	}
+	return update, false
+}
+
+// ApplyPendingSignerUpdates applies signer rotations staged before current ack count.
+// Rotation is deferred while validator is current proposer or has proposed checkpoint waiting in buffer,
+// as rotating signer would orphan that checkpoint.
+func (k *Keeper) ApplyPendingSignerUpdates(ctx sdk.Context) {
+	updates := k.GetAllPendingSignerUpdates(ctx)
+	if len(updates) == 0 {
+		return
+	}
+
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+	validatorSet := k.GetValidatorSet(ctx)
+	bufferedProposers := k.moduleCommunicator.GetBufferedCheckpointProposers(ctx)
+
+	for _, update := range updates {
+		if ackCount < update.EffectiveAckCount {
+			continue
+		}
+
+		validator, ok := k.GetValidatorFromValID(ctx, update.ValidatorID)
+		if !ok {
+			k.Logger(ctx).Error("Dropping signer update of unknown validator", "validatorID", update.ValidatorID)
+			k.RemovePendingSignerUpdate(ctx, update.ValidatorID)
+			continue
+		}
+
+		if !isSafeToRotateSigner(validator, validatorSet, bufferedProposers) {
+			k.Logger(ctx).Info("Deferring signer update of checkpoint proposer", "validatorID", validator.ID, "signer", validator.Signer)
+			continue
+		}
+
+		// validator and fee changes of failed update are discarded
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.applySignerUpdate(cacheCtx, validator, update, ackCount); err != nil {
+			k.Logger(ctx).Error("Unable to apply signer update", "validatorID", validator.ID, "error", err)
+			continue
+		}
+		write()
+
+		k.RemovePendingSignerUpdate(ctx, update.ValidatorID)
+		k.SetValidatorSetChanged(ctx, true)
+	}
+}
+
+// isSafeToRotateSigner checks that validator is neither current proposer nor proposer of buffered checkpoint
+func isSafeToRotateSigner(validator hmTypes.Validator, validatorSet hmTypes.ValidatorSet, bufferedProposers []hmTypes.HeimdallAddress) bool {
+	for _, proposer := range bufferedProposers {
+		if bytes.Equal(proposer.Bytes(), validator.Signer.Bytes()) {
+			return false
+		}
+	}
+
+	// sole validator stays proposer forever
+	proposer := validatorSet.GetProposer()
+	if len(validatorSet.Validators) > 1 && proposer != nil && proposer.ID == validator.ID {
+		return false
+	}
+
+	return true
+}
+
+// applySignerUpdate replaces validator by one with new signer, old signer is removed with next validator set update
+func (k *Keeper) applySignerUpdate(ctx sdk.Context, validator hmTypes.Validator, update types.PendingSignerUpdate, ackCount uint64) error {
+	if bytes.Equal(update.NewSigner.Bytes(), validator.Signer.Bytes()) {
+		return nil
+	}
+
+	oldValidator := validator.Copy()
+
+	// remove old validator from HM and TM
+	oldValidator.EndEpoch = ackCount
+	oldValidator.VotingPower = 0
+	if err := k.AddValidator(ctx, *oldValidator); err != nil {
+		return err
+	}
+
+	validator.Signer = update.NewSigner
+	validator.PubKey = update.NewPubKey
+	if err := k.AddValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("Signer updated", "validatorID", validator.ID, "signer", validator.Signer, "oldSigner", oldValidator.Signer)
+
+	// move heimdall fee to new signer, unless it is already withdrawn
+	coins := k.moduleCommunicator.GetCoins(ctx, oldValidator.Signer)
+	maticBalance := coins.AmountOf(authTypes.FeeToken)
+	if !maticBalance.IsZero() {
+		k.Logger(ctx).Info("Transferring fee", "from", oldValidator.Signer.String(), "to", validator.Signer.String(), "balance", maticBalance.String())
+		maticCoins := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: maticBalance}}
+		if err := k.moduleCommunicator.SendCoins(ctx, oldValidator.Signer, validator.Signer, maticCoins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // UpdateValidatorSetInStore adds validator set to store
 func (k *Keeper) UpdateValidatorSetInStore(ctx sdk.Context, newValidatorSet hmTypes.ValidatorSet) error {
 	// TODO check if we may have to delay this by 1 height to sync with tendermint validator updates
@@ -279,7 +454,9 @@ func (k *Keeper) UpdateValidatorSetInStore(ctx sdk.Context, newValidatorSet hmTy
 
 	// set validator set with CurrentValidatorSetKey as key in store
 	store.Set(CurrentValidatorSetKey, bz)
-	return nil
+
+	// keep history of validator set changes
+	return k.snapshotValidatorSet(ctx, newValidatorSet)
 }
 
 // GetValidatorSet returns current Validator Set from store
@@ -294,6 +471,178 @@ func (k *Keeper) GetValidatorSet(ctx sdk.Context) (validatorSet hmTypes.Validato
 	return validatorSet
 }
 
+// GetValidatorSetSnapshotKey returns key for validator set snapshot at height
+func GetValidatorSetSnapshotKey(height int64) []byte {
+	return append(ValidatorSetSnapshotKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetCheckpointValidatorSetKey returns key for validator set snapshot which signed checkpoint of chain
+func GetCheckpointValidatorSetKey(chainID string, checkpointNumber uint64) []byte {
+	prefix := append(CheckpointValidatorSetKey, byte(len(chainID)))
+	prefix = append(prefix, []byte(chainID)...)
+	return append(prefix, sdk.Uint64ToBigEndian(checkpointNumber)...)
+}
+
+// snapshotValidatorSet stores snapshot of validator set if its validators or their power changed
+func (k *Keeper) snapshotValidatorSet(ctx sdk.Context, validatorSet hmTypes.ValidatorSet) error {
+	snapshot := types.NewValidatorSetSnapshot(ctx.BlockHeight(), validatorSet)
+
+	// proposer priority changes are not part of snapshot
+	if last, ok := k.GetValidatorSetSnapshot(ctx, ctx.BlockHeight()); ok && last.HasSameValidators(snapshot) {
+		return nil
+	}
+
+	if err := k.SetValidatorSetSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+
+	k.pruneValidatorSetSnapshots(ctx)
+	return nil
+}
+
+// SetValidatorSetSnapshot stores validator set snapshot at its height
+func (k *Keeper) SetValidatorSetSnapshot(ctx sdk.Context, snapshot types.ValidatorSetSnapshot) error {
+	bz, err := k.cdc.MarshalBinaryBare(snapshot)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorSetSnapshotKey(snapshot.Height), bz)
+	return nil
+}
+
+// GetAllValidatorSetSnapshots returns all stored validator set snapshots ordered by height
+func (k *Keeper) GetAllValidatorSetSnapshots(ctx sdk.Context) (snapshots []types.ValidatorSetSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorSetSnapshotKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.ValidatorSetSnapshot
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &snapshot); err != nil {
+			k.Logger(ctx).Error("Error while unmarshalling validator set snapshot", "error", err)
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots
+}
+
+// GetValidatorSetSnapshot returns snapshot of validator set in effect at height
+func (k *Keeper) GetValidatorSetSnapshot(ctx sdk.Context, height int64) (snapshot types.ValidatorSetSnapshot, ok bool) {
+	if height < 0 {
+		return snapshot, false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// latest snapshot stored at or before height
+	iterator := store.ReverseIterator(ValidatorSetSnapshotKey, GetValidatorSetSnapshotKey(height+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return snapshot, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &snapshot); err != nil {
+		k.Logger(ctx).Error("Error while unmarshalling validator set snapshot", "height", height, "error", err)
+		return snapshot, false
+	}
+
+	return snapshot, true
+}
+
+// pruneValidatorSetSnapshots removes snapshots older than retention, except one still in effect at retention boundary
+func (k *Keeper) pruneValidatorSetSnapshots(ctx sdk.Context) {
+	retention := k.GetValidatorSetSnapshotRetention(ctx)
+	if retention <= 0 || ctx.BlockHeight() <= retention {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(ValidatorSetSnapshotKey, GetValidatorSetSnapshotKey(ctx.BlockHeight()-retention))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	if len(keys) < 2 {
+		return
+	}
+
+	for _, key := range keys[:len(keys)-1] {
+		store.Delete(key)
+	}
+}
+
+// SetCheckpointValidatorSet records validator set snapshot in effect at given height, at which
+// checkpoint was proposed and signed, as signer of checkpoint of chain
+func (k *Keeper) SetCheckpointValidatorSet(ctx sdk.Context, chainID string, checkpointNumber uint64, height int64) error {
+	snapshot, ok := k.GetValidatorSetSnapshot(ctx, height)
+	if !ok {
+		return errors.New("No validator set snapshot found")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetCheckpointValidatorSetKey(chainID, checkpointNumber), sdk.Uint64ToBigEndian(uint64(snapshot.Height)))
+	return nil
+}
+
+// GetAllCheckpointValidatorSets returns heights of validator set snapshots which signed checkpoints of all chains
+func (k *Keeper) GetAllCheckpointValidatorSets(ctx sdk.Context) (checkpointValidatorSets []types.CheckpointValidatorSet) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, CheckpointValidatorSetKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key is prefix, chain id length, chain id and checkpoint number
+		key := iterator.Key()[len(CheckpointValidatorSetKey):]
+		chainIDLength := int(key[0])
+		checkpointValidatorSets = append(checkpointValidatorSets, types.CheckpointValidatorSet{
+			ChainID:          string(key[1 : 1+chainIDLength]),
+			CheckpointNumber: binary.BigEndian.Uint64(key[1+chainIDLength:]),
+			Height:           int64(binary.BigEndian.Uint64(iterator.Value())),
+		})
+	}
+
+	return checkpointValidatorSets
+}
+
+// GetValidatorSetSnapshotByCheckpoint returns snapshot of validator set which signed checkpoint of chain
+func (k *Keeper) GetValidatorSetSnapshotByCheckpoint(ctx sdk.Context, chainID string, checkpointNumber uint64) (snapshot types.ValidatorSetSnapshot, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetCheckpointValidatorSetKey(chainID, checkpointNumber))
+	if bz == nil {
+		return snapshot, false
+	}
+
+	height := int64(binary.BigEndian.Uint64(bz))
+	snapshot, ok = k.GetValidatorSetSnapshot(ctx, height)
+
+	// snapshot has been pruned
+	if !ok || snapshot.Height != height {
+		return snapshot, false
+	}
+
+	return snapshot, true
+}
+
+// GetValidatorSetSnapshotRetention returns number of blocks validator set snapshots are kept for
+func (k *Keeper) GetValidatorSetSnapshotRetention(ctx sdk.Context) int64 {
+	retention := types.DefaultValidatorSetSnapshotRetention
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyValidatorSetSnapshotRetention, &retention)
+	return retention
+}
+
+// SetValidatorSetSnapshotRetention sets number of blocks validator set snapshots are kept for
+func (k *Keeper) SetValidatorSetSnapshotRetention(ctx sdk.Context, retention int64) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyValidatorSetSnapshotRetention, retention)
+}
+
 // IncrementAccum increments accum for validator set by n times and replace validator set in store
 func (k *Keeper) IncrementAccum(ctx sdk.Context, times int) {
 	// get validator set
@@ -436,21 +785,25 @@ func (k *Keeper) GetAllDividendAccounts(ctx sdk.Context) (dividendAccounts []hmT
 	return
 }
 
-// AddFeeToDividendAccount adds fee to dividend account for withdrawal
-func (k *Keeper) AddFeeToDividendAccount(ctx sdk.Context, valID hmTypes.ValidatorID, fee *big.Int) sdk.Error {
-	// Get or create dividend account
-	var dividendAccount hmTypes.DividendAccount
-
+// getOrCreateDividendAccount returns dividend account of validator, or a new empty one
+func (k *Keeper) getOrCreateDividendAccount(ctx sdk.Context, valID hmTypes.ValidatorID) (dividendAccount hmTypes.DividendAccount) {
 	if k.CheckIfDividendAccountExists(ctx, hmTypes.DividendAccountID(valID)) {
 		dividendAccount, _ = k.GetDividendAccountByID(ctx, hmTypes.DividendAccountID(valID))
-	} else {
-		dividendAccount = hmTypes.DividendAccount{
-			ID:            hmTypes.DividendAccountID(valID),
-			FeeAmount:     big.NewInt(0).String(),
-			SlashedAmount: big.NewInt(0).String(),
-		}
+		return dividendAccount
 	}
 
+	return hmTypes.DividendAccount{
+		ID:            hmTypes.DividendAccountID(valID),
+		FeeAmount:     big.NewInt(0).String(),
+		SlashedAmount: big.NewInt(0).String(),
+	}
+}
+
+// AddFeeToDividendAccount adds fee to dividend account for withdrawal
+func (k *Keeper) AddFeeToDividendAccount(ctx sdk.Context, valID hmTypes.ValidatorID, fee *big.Int) sdk.Error {
+	// Get or create dividend account
+	dividendAccount := k.getOrCreateDividendAccount(ctx, valID)
+
 	// update fee
 	oldFee, _ := big.NewInt(0).SetString(dividendAccount.FeeAmount, 10)
 	totalFee := big.NewInt(0).Add(oldFee, fee).String()
@@ -461,6 +814,21 @@ func (k *Keeper) AddFeeToDividendAccount(ctx sdk.Context, valID hmTypes.Validato
 	return nil
 }
 
+// AddSlashedAmountToDividendAccount adds slashed amount to dividend account, it goes to account root
+// with next checkpoint so that slashing is enforced on rootchain
+func (k *Keeper) AddSlashedAmountToDividendAccount(ctx sdk.Context, valID hmTypes.ValidatorID, amount *big.Int) sdk.Error {
+	// Get or create dividend account
+	dividendAccount := k.getOrCreateDividendAccount(ctx, valID)
+
+	// update slashed amount
+	oldSlashedAmount, _ := big.NewInt(0).SetString(dividendAccount.SlashedAmount, 10)
+	dividendAccount.SlashedAmount = big.NewInt(0).Add(oldSlashedAmount, amount).String()
+
+	k.Logger(ctx).Info("Dividend Account slashed amount of validator ", "ID", dividendAccount.ID, "SlashedAmount", dividendAccount.SlashedAmount)
+	k.AddDividendAccount(ctx, dividendAccount)
+	return nil
+}
+
 // IterateDividendAccountsByPrefixAndApplyFn iterate dividendAccounts and apply the given function.
 func (k *Keeper) IterateDividendAccountsByPrefixAndApplyFn(ctx sdk.Context, prefix []byte, f func(dividendAccount hmTypes.DividendAccount) error) {
 	store := ctx.KVStore(k.storeKey)
@@ -490,6 +858,66 @@ func (k *Keeper) IterateCurrentValidatorsAndApplyFn(ctx sdk.Context, f func(vali
 	}
 }
 
+//
+// Jailing
+//
+
+// JailValidator jails validator, it is removed from validator set with next validator set update
+func (k *Keeper) JailValidator(ctx sdk.Context, valID hmTypes.ValidatorID) error {
+	validator, ok := k.GetValidatorFromValID(ctx, valID)
+	if !ok {
+		return errors.New("Validator not found")
+	}
+
+	if validator.Jailed {
+		return nil
+	}
+
+	validator.Jailed = true
+	if err := k.AddValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	k.SetValidatorSetChanged(ctx, true)
+	return nil
+}
+
+// UnjailValidator unjails validator, it is added back to validator set with next validator set update
+func (k *Keeper) UnjailValidator(ctx sdk.Context, valID hmTypes.ValidatorID) error {
+	validator, ok := k.GetValidatorFromValID(ctx, valID)
+	if !ok {
+		return errors.New("Validator not found")
+	}
+
+	if !validator.Jailed {
+		return nil
+	}
+
+	validator.Jailed = false
+	if err := k.AddValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	k.SetValidatorSetChanged(ctx, true)
+	return nil
+}
+
+// SetValidatorSetChanged sets flag to update validator set in next end block, even without txs
+func (k *Keeper) SetValidatorSetChanged(ctx sdk.Context, changed bool) {
+	store := ctx.KVStore(k.storeKey)
+	if changed {
+		store.Set(ValidatorSetChangeKey, DefaultValue)
+	} else {
+		store.Delete(ValidatorSetChangeKey)
+	}
+}
+
+// IsValidatorSetChanged checks if validator set has to be updated in end block
+func (k *Keeper) IsValidatorSetChanged(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(ValidatorSetChangeKey)
+}
+
 //
 // Staking sequence
 //
@@ -2,15 +2,22 @@ package staking_test
 
 import (
 	"encoding/hex"
-	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/heimdall/app"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/checkpoint"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	cmn "github.com/maticnetwork/heimdall/test"
 	"github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
@@ -321,9 +328,198 @@ func TestDividendAccountTree(t *testing.T) {
 
 // }
 
-func TestGetValidatorsFromSigs(t *testing.T) {
+// tests validator set snapshots by height and checkpoint, and their pruning
+func TestValidatorSetSnapshot(t *testing.T) {
 	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	validators := cmn.GenRandomVal(2, 0, 10, uint64(10), false, 1)
+
+	var valSet types.ValidatorSet
+	require.NoError(t, valSet.UpdateWithChangeSet([]*types.Validator{&validators[0]}))
+	require.NoError(t, keeper.UpdateValidatorSetInStore(ctx.WithBlockHeight(10), valSet))
+
+	// proposer priority change is not stored as new snapshot
+	valSet.IncrementProposerPriority(1)
+	require.NoError(t, keeper.UpdateValidatorSetInStore(ctx.WithBlockHeight(15), valSet))
+	require.NoError(t, keeper.SetCheckpointValidatorSet(ctx.WithBlockHeight(15), "15001", 1, 15))
+
+	require.NoError(t, valSet.UpdateWithChangeSet([]*types.Validator{&validators[1]}))
+	require.NoError(t, keeper.UpdateValidatorSetInStore(ctx.WithBlockHeight(20), valSet))
+	require.NoError(t, keeper.SetCheckpointValidatorSet(ctx.WithBlockHeight(25), "15001", 2, 25))
+
+	// checkpoint proposed before validator set change, but acked after it
+	require.NoError(t, keeper.SetCheckpointValidatorSet(ctx.WithBlockHeight(25), "15002", 1, 18))
+
+	_, ok := keeper.GetValidatorSetSnapshot(ctx, 9)
+	require.False(t, ok)
+
+	snapshot, ok := keeper.GetValidatorSetSnapshot(ctx, 19)
+	require.True(t, ok)
+	require.Equal(t, int64(10), snapshot.Height)
+	require.Len(t, snapshot.Validators, 1)
+
+	snapshot, ok = keeper.GetValidatorSetSnapshot(ctx, 20)
+	require.True(t, ok)
+	require.Len(t, snapshot.Validators, 2)
+	require.Equal(t, int64(20), snapshot.TotalVotingPower)
+
+	snapshot, ok = keeper.GetValidatorSetSnapshotByCheckpoint(ctx, "15001", 1)
+	require.True(t, ok)
+	require.Equal(t, int64(10), snapshot.Height)
+
+	snapshot, ok = keeper.GetValidatorSetSnapshotByCheckpoint(ctx, "15002", 1)
+	require.True(t, ok)
+	require.Equal(t, int64(10), snapshot.Height)
+	require.Len(t, snapshot.Validators, 1)
+
+	// snapshots older than retention are pruned, except one in effect at retention boundary
+	keeper.SetValidatorSetSnapshotRetention(ctx, 5)
+	removed := validators[0].Copy()
+	removed.VotingPower = 0
+	require.NoError(t, valSet.UpdateWithChangeSet([]*types.Validator{removed}))
+	require.NoError(t, keeper.UpdateValidatorSetInStore(ctx.WithBlockHeight(30), valSet))
+
+	_, ok = keeper.GetValidatorSetSnapshotByCheckpoint(ctx, "15001", 1)
+	require.False(t, ok)
+
+	snapshot, ok = keeper.GetValidatorSetSnapshotByCheckpoint(ctx, "15001", 2)
+	require.True(t, ok)
+	require.Equal(t, int64(20), snapshot.Height)
+
+	snapshot, ok = keeper.GetValidatorSetSnapshot(ctx, 30)
+	require.True(t, ok)
+	require.Len(t, snapshot.Validators, 1)
+}
+
+func TestPendingSignerUpdate(t *testing.T) {
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	validators := cmn.GenRandomVal(2, 0, 10, uint64(10), false, 1)
+
+	newPubKey := types.NewPubKey(secp256k1.GenPrivKey().PubKey().Bytes())
+	update := stakingTypes.NewPendingSignerUpdate(validators[0].ID, newPubKey, 2)
+	require.NoError(t, keeper.SetPendingSignerUpdate(ctx, update))
+
+	result, ok := keeper.GetPendingSignerUpdate(ctx, validators[0].ID)
+	require.True(t, ok)
+	require.Equal(t, update.NewSigner, result.NewSigner)
+	require.Equal(t, uint64(2), result.EffectiveAckCount)
+	result, ok = keeper.GetPendingSignerUpdateBySigner(ctx, newPubKey.Address().Bytes())
+	require.True(t, ok)
+	require.Equal(t, validators[0].ID, result.ValidatorID)
+
+	_, ok = keeper.GetPendingSignerUpdate(ctx, validators[1].ID)
+	require.False(t, ok)
+
+	// later rotation replaces earlier one
+	update = stakingTypes.NewPendingSignerUpdate(validators[0].ID, types.NewPubKey(secp256k1.GenPrivKey().PubKey().Bytes()), 3)
+	require.NoError(t, keeper.SetPendingSignerUpdate(ctx, update))
+	require.Len(t, keeper.GetAllPendingSignerUpdates(ctx), 1)
+	_, ok = keeper.GetPendingSignerUpdateBySigner(ctx, newPubKey.Address().Bytes())
+	require.False(t, ok)
+
+	keeper.RemovePendingSignerUpdate(ctx, validators[0].ID)
+	require.Empty(t, keeper.GetAllPendingSignerUpdates(ctx))
+}
+
+// nonProposer returns validator of validator set which is not its current proposer
+func nonProposer(valSet types.ValidatorSet) types.Validator {
+	proposer := valSet.GetProposer()
+	for _, validator := range valSet.Validators {
+		if validator.ID != proposer.ID {
+			return *validator
+		}
+	}
+	return types.Validator{}
+}
+
+func TestApplyPendingSignerUpdates(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{ChainID: "foochainid", Height: 1, Time: time.Now().UTC()})
+	keeper, ck := happ.StakingKeeper, happ.CheckpointKeeper
+
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	validator := nonProposer(keeper.GetValidatorSet(ctx))
+
+	newPubKey := types.NewPubKey(secp256k1.GenPrivKey().PubKey().Bytes())
+	update := stakingTypes.NewPendingSignerUpdate(validator.ID, newPubKey, 1)
+	require.NoError(t, keeper.SetPendingSignerUpdate(ctx, update))
+
+	fee := sdk.Coins{sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(100))}
+	require.NoError(t, happ.BankKeeper.SetCoins(ctx, validator.Signer, fee))
+
+	// rotation waits for next ack
+	keeper.ApplyPendingSignerUpdates(ctx)
+	_, ok := keeper.GetPendingSignerUpdate(ctx, validator.ID)
+	require.True(t, ok)
+	require.False(t, keeper.IsValidatorSetChanged(ctx))
+
+	ck.UpdateACKCountWithValue(ctx, 1)
+	keeper.ApplyPendingSignerUpdates(ctx)
+
+	_, ok = keeper.GetPendingSignerUpdate(ctx, validator.ID)
+	require.False(t, ok, "applied signer update should be removed")
+	require.True(t, keeper.IsValidatorSetChanged(ctx))
+
+	rotated, ok := keeper.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, ok)
+	require.Equal(t, update.NewSigner, rotated.Signer)
+	require.Equal(t, newPubKey, rotated.PubKey)
+	require.Equal(t, validator.VotingPower, rotated.VotingPower)
+
+	// old signer is removed with next validator set update
+	old, err := keeper.GetValidatorInfo(ctx, validator.Signer.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), old.EndEpoch)
+	require.Zero(t, old.VotingPower)
+
+	// heimdall fee moves to new signer
+	require.True(t, happ.BankKeeper.GetCoins(ctx, validator.Signer).IsZero())
+	require.Equal(t, fee, happ.BankKeeper.GetCoins(ctx, update.NewSigner))
+}
+
+func TestApplyPendingSignerUpdatesDeferred(t *testing.T) {
+	// rotation is applied with ack once validator has no checkpoint to propose or acknowledge
+	setup := func(t *testing.T, selectValidator func(valSet types.ValidatorSet) types.Validator) (sdk.Context, staking.Keeper, checkpoint.Keeper, types.Validator, stakingTypes.PendingSignerUpdate) {
+		ctx, keeper, ck := cmn.CreateTestInput(t, false)
+		cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+		validator := selectValidator(keeper.GetValidatorSet(ctx))
+
+		update := stakingTypes.NewPendingSignerUpdate(validator.ID, types.NewPubKey(secp256k1.GenPrivKey().PubKey().Bytes()), 1)
+		require.NoError(t, keeper.SetPendingSignerUpdate(ctx, update))
+		ck.UpdateACKCountWithValue(ctx, 1)
+		return ctx, keeper, ck, validator, update
+	}
 
-	validatorAddresses := keeper.GetValidatorsFromSigs(ctx, voteSignBytes, inputSigs)
-	fmt.Println(validatorAddresses)
+	requireDeferred := func(t *testing.T, ctx sdk.Context, keeper staking.Keeper, validator types.Validator) {
+		keeper.ApplyPendingSignerUpdates(ctx)
+
+		_, ok := keeper.GetPendingSignerUpdate(ctx, validator.ID)
+		require.True(t, ok, "signer update should stay pending")
+
+		current, ok := keeper.GetValidatorFromValID(ctx, validator.ID)
+		require.True(t, ok)
+		require.Equal(t, validator.Signer, current.Signer)
+	}
+
+	t.Run("currentProposer", func(t *testing.T) {
+		ctx, keeper, _, validator, _ := setup(t, func(valSet types.ValidatorSet) types.Validator {
+			return *valSet.GetProposer()
+		})
+		requireDeferred(t, ctx, keeper, validator)
+	})
+
+	t.Run("bufferedProposer", func(t *testing.T) {
+		ctx, keeper, ck, validator, update := setup(t, nonProposer)
+
+		header := types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HeimdallHash{}, validator.Signer, uint64(ctx.BlockTime().Unix()))
+		require.NoError(t, ck.SetCheckpointBuffer(ctx, header))
+		requireDeferred(t, ctx, keeper, validator)
+
+		// applied once buffered checkpoint is gone
+		ck.FlushCheckpointBuffer(ctx)
+		keeper.ApplyPendingSignerUpdates(ctx)
+
+		rotated, ok := keeper.GetValidatorFromValID(ctx, validator.ID)
+		require.True(t, ok)
+		require.Equal(t, update.NewSigner, rotated.Signer)
+	})
 }
@@ -23,10 +23,20 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryCurrentValidatorSet:
 			return handleQueryCurrentValidatorSet(ctx, req, keeper)
+		case types.QueryAllValidators:
+			return handleQueryAllValidators(ctx, req, keeper)
+		case types.QueryValidatorSetByHeight:
+			return handleQueryValidatorSetByHeight(ctx, req, keeper)
+		case types.QueryValidatorSetByCheckpoint:
+			return handleQueryValidatorSetByCheckpoint(ctx, req, keeper)
 		case types.QuerySigner:
 			return handleQuerySigner(ctx, req, keeper)
 		case types.QueryValidator:
 			return handleQueryValidator(ctx, req, keeper)
+		case types.QueryValidatorMissedBlocks:
+			return handleQueryValidatorMissedBlocks(ctx, req, keeper)
+		case types.QueryPendingSignerUpdate:
+			return handleQueryPendingSignerUpdate(ctx, req, keeper)
 		case types.QueryValidatorStatus:
 			return handleQueryValidatorStatus(ctx, req, keeper)
 		case types.QueryProposer:
@@ -62,6 +72,64 @@ func handleQueryCurrentValidatorSet(ctx sdk.Context, req abci.RequestQuery, keep
 	return bz, nil
 }
 
+func handleQueryAllValidators(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	// get all validators in state
+	validators := keeper.GetAllValidators(ctx)
+
+	// json record
+	bz, err := json.Marshal(validators)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorSetByHeight(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorSetByHeightParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get validator set snapshot in effect at height
+	snapshot, ok := keeper.GetValidatorSetSnapshot(ctx, params.Height)
+	if !ok {
+		return nil, sdk.ErrUnknownRequest("No validator set snapshot found")
+	}
+
+	// json record
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorSetByCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorSetByCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// default checkpoint chain
+	chainID := params.ChainID
+	if chainID == "" {
+		chainID = keeper.chainKeeper.GetParams(ctx).ChainParams.BorChainID
+	}
+
+	// get validator set snapshot which signed checkpoint
+	snapshot, ok := keeper.GetValidatorSetSnapshotByCheckpoint(ctx, chainID, params.CheckpointNumber)
+	if !ok {
+		return nil, sdk.ErrUnknownRequest("No validator set snapshot found for checkpoint")
+	}
+
+	// json record
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQuerySigner(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QuerySignerParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
@@ -102,6 +170,46 @@ func handleQueryValidator(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	return bz, nil
 }
 
+func handleQueryValidatorMissedBlocks(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get missed blocks stats
+	liveness, ok := keeper.moduleCommunicator.GetValidatorLiveness(ctx, params.ValidatorID)
+	if !ok {
+		return nil, sdk.ErrUnknownRequest("No validator found")
+	}
+
+	// json record
+	bz, err := json.Marshal(liveness)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryPendingSignerUpdate(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get signer rotation staged for validator
+	update, ok := keeper.GetPendingSignerUpdate(ctx, params.ValidatorID)
+	if !ok {
+		return nil, nil
+	}
+
+	// json record
+	bz, err := json.Marshal(update)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryValidatorStatus(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QuerySignerParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
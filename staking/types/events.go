@@ -13,6 +13,7 @@ var (
 	AttributeKeyActivationEpoch   = "activation-epoch"
 	AttributeKeyValidatorID       = "validator-id"
 	AttributeKeyUpdatedAt         = "updated-at"
+	AttributeKeyEffectiveAckCount = "effective-ack-count"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -36,6 +38,10 @@ type GenesisState struct {
 	CurrentValSet    hmTypes.ValidatorSet      `json:"current_val_set" yaml:"current_val_set"`
 	DividentAccounts []hmTypes.DividendAccount `json:"dividend_accounts" yaml:"dividend_accounts"`
 	StakingSequences []string                  `json:"staking_sequences" yaml:"staking_sequences"`
+
+	PendingSignerUpdates    []PendingSignerUpdate    `json:"pending_signer_updates" yaml:"pending_signer_updates"`
+	ValidatorSetSnapshots   []ValidatorSetSnapshot   `json:"validator_set_snapshots" yaml:"validator_set_snapshots"`
+	CheckpointValidatorSets []CheckpointValidatorSet `json:"checkpoint_validator_sets" yaml:"checkpoint_validator_sets"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -44,18 +50,24 @@ func NewGenesisState(
 	currentValSet hmTypes.ValidatorSet,
 	dividentAccounts []hmTypes.DividendAccount,
 	stakingSequences []string,
+	pendingSignerUpdates []PendingSignerUpdate,
+	validatorSetSnapshots []ValidatorSetSnapshot,
+	checkpointValidatorSets []CheckpointValidatorSet,
 ) GenesisState {
 	return GenesisState{
-		Validators:       validators,
-		CurrentValSet:    currentValSet,
-		DividentAccounts: dividentAccounts,
-		StakingSequences: stakingSequences,
+		Validators:              validators,
+		CurrentValSet:           currentValSet,
+		DividentAccounts:        dividentAccounts,
+		StakingSequences:        stakingSequences,
+		PendingSignerUpdates:    pendingSignerUpdates,
+		ValidatorSetSnapshots:   validatorSetSnapshots,
+		CheckpointValidatorSets: checkpointValidatorSets,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(nil, hmTypes.ValidatorSet{}, nil, nil)
+	return NewGenesisState(nil, hmTypes.ValidatorSet{}, nil, nil, nil, nil, nil)
 }
 
 // ValidateGenesis performs basic validation of bor genesis data returning an
@@ -72,6 +84,47 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	pendingValidators := make(map[hmTypes.ValidatorID]bool, len(data.PendingSignerUpdates))
+	for _, update := range data.PendingSignerUpdates {
+		if update.ValidatorID == 0 || len(update.NewPubKey.Bytes()) == 0 {
+			return errors.New("Invalid pending signer update")
+		}
+		if !bytes.Equal(update.NewSigner.Bytes(), update.NewPubKey.Address().Bytes()) {
+			return fmt.Errorf("Signer of pending signer update of validator %v does not match its pubkey", update.ValidatorID)
+		}
+		if pendingValidators[update.ValidatorID] {
+			return fmt.Errorf("Duplicate pending signer update of validator %v", update.ValidatorID)
+		}
+		pendingValidators[update.ValidatorID] = true
+	}
+
+	snapshotHeights := make(map[int64]bool, len(data.ValidatorSetSnapshots))
+	for _, snapshot := range data.ValidatorSetSnapshots {
+		if snapshot.Height < 0 || snapshotHeights[snapshot.Height] {
+			return fmt.Errorf("Invalid or duplicate validator set snapshot height %v", snapshot.Height)
+		}
+		totalVotingPower := int64(0)
+		for _, validator := range snapshot.Validators {
+			totalVotingPower += validator.VotingPower
+		}
+		if snapshot.TotalVotingPower != totalVotingPower {
+			return fmt.Errorf("Total voting power of validator set snapshot at height %v does not match its validators", snapshot.Height)
+		}
+		snapshotHeights[snapshot.Height] = true
+	}
+
+	checkpoints := make(map[string]bool, len(data.CheckpointValidatorSets))
+	for _, checkpointValidatorSet := range data.CheckpointValidatorSets {
+		key := fmt.Sprintf("%v/%v", checkpointValidatorSet.ChainID, checkpointValidatorSet.CheckpointNumber)
+		if checkpoints[key] {
+			return fmt.Errorf("Duplicate validator set of checkpoint %v", key)
+		}
+		if !snapshotHeights[checkpointValidatorSet.Height] {
+			return fmt.Errorf("No validator set snapshot at height %v for checkpoint %v", checkpointValidatorSet.Height, key)
+		}
+		checkpoints[key] = true
+	}
+
 	return nil
 }
 
@@ -8,14 +8,21 @@ const (
 
 	// DefaultProposerBonusPercent - Proposer Signer Reward Ratio
 	DefaultProposerBonusPercent = int64(10)
+
+	// DefaultValidatorSetSnapshotRetention - Number of blocks validator set snapshots are kept for, 0 keeps all
+	DefaultValidatorSetSnapshotRetention = int64(0)
 )
 
 // ParamStoreKeyProposerBonusPercent - Store's Key for Reward amount
 var ParamStoreKeyProposerBonusPercent = []byte("proposerbonuspercent")
 
+// ParamStoreKeyValidatorSetSnapshotRetention - Store's Key for validator set snapshot retention
+var ParamStoreKeyValidatorSetSnapshotRetention = []byte("validatorsetsnapshotretention")
+
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() subspace.KeyTable {
 	return subspace.NewKeyTable(
 		ParamStoreKeyProposerBonusPercent, DefaultProposerBonusPercent,
+		ParamStoreKeyValidatorSetSnapshotRetention, DefaultValidatorSetSnapshotRetention,
 	)
 }
@@ -9,19 +9,24 @@ import (
 
 // query endpoints supported by the staking Querier
 const (
-	QueryCurrentValidatorSet  = "current-validator-set"
-	QuerySigner               = "signer"
-	QueryValidator            = "validator"
-	QueryValidatorStatus      = "validator-status"
-	QueryProposer             = "proposer"
-	QueryCurrentProposer      = "current-proposer"
-	QueryProposerBonusPercent = "proposer-bonus-percent"
-	QueryDividendAccount      = "dividend-account"
-	QueryDividendAccountRoot  = "dividend-account-root"
-	QueryAccountProof         = "dividend-account-proof"
-	QueryVerifyAccountProof   = "verify-account-proof"
-	QuerySlashValidator       = "slash-validator"
-	QueryStakingSequence      = "staking-sequence"
+	QueryCurrentValidatorSet      = "current-validator-set"
+	QuerySigner                   = "signer"
+	QueryValidator                = "validator"
+	QueryValidatorStatus          = "validator-status"
+	QueryValidatorMissedBlocks    = "validator-missed-blocks"
+	QueryPendingSignerUpdate      = "pending-signer-update"
+	QueryProposer                 = "proposer"
+	QueryCurrentProposer          = "current-proposer"
+	QueryProposerBonusPercent     = "proposer-bonus-percent"
+	QueryDividendAccount          = "dividend-account"
+	QueryDividendAccountRoot      = "dividend-account-root"
+	QueryAccountProof             = "dividend-account-proof"
+	QueryVerifyAccountProof       = "verify-account-proof"
+	QuerySlashValidator           = "slash-validator"
+	QueryStakingSequence          = "staking-sequence"
+	QueryAllValidators            = "all-validators"
+	QueryValidatorSetByHeight     = "validator-set-by-height"
+	QueryValidatorSetByCheckpoint = "validator-set-by-checkpoint"
 )
 
 // QuerySignerParams defines the params for querying by address
@@ -85,6 +90,27 @@ func NewQueryProposerParams(times uint64) QueryProposerParams {
 	return QueryProposerParams{Times: times}
 }
 
+// QueryValidatorSetByHeightParams defines the params for querying validator set at height.
+type QueryValidatorSetByHeightParams struct {
+	Height int64 `json:"height"`
+}
+
+// NewQueryValidatorSetByHeightParams creates a new instance of QueryValidatorSetByHeightParams.
+func NewQueryValidatorSetByHeightParams(height int64) QueryValidatorSetByHeightParams {
+	return QueryValidatorSetByHeightParams{Height: height}
+}
+
+// QueryValidatorSetByCheckpointParams defines the params for querying validator set which signed checkpoint.
+type QueryValidatorSetByCheckpointParams struct {
+	ChainID          string `json:"chain_id"`
+	CheckpointNumber uint64 `json:"checkpoint_number"`
+}
+
+// NewQueryValidatorSetByCheckpointParams creates a new instance of QueryValidatorSetByCheckpointParams.
+func NewQueryValidatorSetByCheckpointParams(chainID string, checkpointNumber uint64) QueryValidatorSetByCheckpointParams {
+	return QueryValidatorSetByCheckpointParams{ChainID: chainID, CheckpointNumber: checkpointNumber}
+}
+
 // QueryValidatorStatusParams defines the params for querying val status.
 type QueryValidatorStatusParams struct {
 	SignerAddress []byte
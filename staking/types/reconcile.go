@@ -0,0 +1,265 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Fields compared by staking reconciliation
+const (
+	DiffFieldMissing           = "missing"
+	DiffFieldPower             = "power"
+	DiffFieldSigner            = "signer"
+	DiffFieldDeactivationEpoch = "deactivation-epoch"
+)
+
+// ValidatorDiff is difference between validator in heimdall state and on StakingInfo contract
+type ValidatorDiff struct {
+	ID       hmTypes.ValidatorID `json:"ID"`
+	Field    string              `json:"field"`
+	Heimdall string              `json:"heimdall"`
+	Onchain  string              `json:"onchain"`
+}
+
+// String returns string representation of diff
+func (d ValidatorDiff) String() string {
+	return fmt.Sprintf("ValidatorDiff{%v %v heimdall: %v onchain: %v}", d.ID, d.Field, d.Heimdall, d.Onchain)
+}
+
+// CorrectingMsg is staking msg which replays StakingInfo event missed by heimdall
+type CorrectingMsg struct {
+	Diff     ValidatorDiff        `json:"diff"`
+	Msg      sdk.Msg              `json:"msg"`
+	TxHash   hmTypes.HeimdallHash `json:"tx_hash"`
+	LogIndex uint64               `json:"log_index"`
+}
+
+// CompareValidator compares validator in heimdall state against validator on StakingInfo contract
+func CompareValidator(validator hmTypes.Validator, onchain hmTypes.Validator) (diffs []ValidatorDiff) {
+	if !bytes.Equal(validator.Signer.Bytes(), onchain.Signer.Bytes()) {
+		diffs = append(diffs, ValidatorDiff{
+			ID:       validator.ID,
+			Field:    DiffFieldSigner,
+			Heimdall: validator.Signer.String(),
+			Onchain:  onchain.Signer.String(),
+		})
+	}
+
+	if validator.EndEpoch != onchain.EndEpoch {
+		diffs = append(diffs, ValidatorDiff{
+			ID:       validator.ID,
+			Field:    DiffFieldDeactivationEpoch,
+			Heimdall: strconv.FormatUint(validator.EndEpoch, 10),
+			Onchain:  strconv.FormatUint(onchain.EndEpoch, 10),
+		})
+	}
+
+	// stake of exiting validator is not tracked by heimdall after unstake
+	if onchain.EndEpoch != 0 {
+		return diffs
+	}
+
+	if validator.VotingPower != onchain.VotingPower {
+		diffs = append(diffs, ValidatorDiff{
+			ID:       validator.ID,
+			Field:    DiffFieldPower,
+			Heimdall: strconv.FormatInt(validator.VotingPower, 10),
+			Onchain:  strconv.FormatInt(onchain.VotingPower, 10),
+		})
+	}
+
+	return diffs
+}
+
+// ReconcileValidators compares all validators in heimdall state against StakingInfo contract,
+// and reports validators in current validator set of stake manager which are missing in heimdall
+func ReconcileValidators(
+	contractCaller helper.IContractCaller,
+	stakingInfoAddress common.Address,
+	stakeManagerAddress common.Address,
+	validators []*hmTypes.Validator,
+) ([]ValidatorDiff, error) {
+	stakingInfoInstance, err := contractCaller.GetStakingInfoInstance(stakingInfoAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	stakeManagerInstance, err := contractCaller.GetStakeManagerInstance(stakeManagerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := make([]ValidatorDiff, 0)
+	known := make(map[hmTypes.ValidatorID]bool, len(validators))
+	for _, validator := range validators {
+		known[validator.ID] = true
+
+		onchain, err := contractCaller.GetValidatorInfo(validator.ID, stakingInfoInstance)
+		if err != nil {
+			return nil, err
+		}
+
+		diffs = append(diffs, CompareValidator(*validator, onchain)...)
+	}
+
+	// validators staked on stake manager without validator join on heimdall
+	currentIDs, err := stakeManagerInstance.GetCurrentValidatorSet(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range currentIDs {
+		validatorID := hmTypes.NewValidatorID(id.Uint64())
+		if known[validatorID] {
+			continue
+		}
+
+		onchain, err := contractCaller.GetValidatorInfo(validatorID, stakingInfoInstance)
+		if err != nil {
+			return nil, err
+		}
+
+		diffs = append(diffs, ValidatorDiff{
+			ID:      validatorID,
+			Field:   DiffFieldMissing,
+			Onchain: onchain.Signer.String(),
+		})
+	}
+
+	return diffs, nil
+}
+
+// SkipPendingSignerDiffs drops signer diffs which match signer rotation staged until next checkpoint ack,
+// as StakingInfo contract already has new signer while heimdall keeps old one
+func SkipPendingSignerDiffs(diffs []ValidatorDiff, pendingUpdates []PendingSignerUpdate) []ValidatorDiff {
+	pendingSigners := make(map[hmTypes.ValidatorID]hmTypes.HeimdallAddress, len(pendingUpdates))
+	for _, update := range pendingUpdates {
+		pendingSigners[update.ValidatorID] = update.NewSigner
+	}
+
+	result := make([]ValidatorDiff, 0, len(diffs))
+	for _, diff := range diffs {
+		if diff.Field == DiffFieldSigner {
+			if newSigner, ok := pendingSigners[diff.ID]; ok && bytes.Equal(newSigner.Bytes(), hmTypes.HexToHeimdallAddress(diff.Onchain).Bytes()) {
+				continue
+			}
+		}
+		result = append(result, diff)
+	}
+
+	return result
+}
+
+// GetCorrectingMsgs builds staking msgs replaying latest StakingInfo event behind each diff,
+// events are searched between start and end block of main chain (both inclusive)
+func GetCorrectingMsgs(
+	contractCaller helper.IContractCaller,
+	stakingInfoAddress common.Address,
+	from hmTypes.HeimdallAddress,
+	diffs []ValidatorDiff,
+	startBlock uint64,
+	endBlock uint64,
+) ([]CorrectingMsg, error) {
+	stakingInfoInstance, err := contractCaller.GetStakingInfoInstance(stakingInfoAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &bind.FilterOpts{Start: startBlock, End: &endBlock}
+	result := make([]CorrectingMsg, 0, len(diffs))
+	for _, diff := range diffs {
+		id := []*big.Int{new(big.Int).SetUint64(diff.ID.Uint64())}
+
+		// latest event of diff kind for validator
+		var vLog *ethTypes.Log
+		var signerPubKey []byte
+		switch diff.Field {
+		case DiffFieldMissing:
+			iterator, err := stakingInfoInstance.FilterStaked(opts, nil, id, nil)
+			if err != nil {
+				return nil, err
+			}
+			for iterator.Next() {
+				vLog, signerPubKey = &iterator.Event.Raw, iterator.Event.SignerPubkey
+			}
+			iterator.Close()
+
+		case DiffFieldSigner:
+			iterator, err := stakingInfoInstance.FilterSignerChange(opts, id, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			for iterator.Next() {
+				vLog, signerPubKey = &iterator.Event.Raw, iterator.Event.SignerPubkey
+			}
+			iterator.Close()
+
+		case DiffFieldDeactivationEpoch:
+			iterator, err := stakingInfoInstance.FilterUnstakeInit(opts, nil, id, nil)
+			if err != nil {
+				return nil, err
+			}
+			for iterator.Next() {
+				vLog = &iterator.Event.Raw
+			}
+			iterator.Close()
+
+		case DiffFieldPower:
+			iterator, err := stakingInfoInstance.FilterStakeUpdate(opts, id, nil)
+			if err != nil {
+				return nil, err
+			}
+			for iterator.Next() {
+				vLog = &iterator.Event.Raw
+			}
+			iterator.Close()
+		}
+
+		// no event to replay, eg. difference caused by event older than start block
+		if vLog == nil {
+			continue
+		}
+
+		txHash := hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes())
+		logIndex := uint64(vLog.Index)
+
+		var msg sdk.Msg
+		switch diff.Field {
+		case DiffFieldMissing:
+			msg = NewMsgValidatorJoin(from, diff.ID.Uint64(), hmTypes.NewPubKey(uncompressedPubKey(signerPubKey)), txHash, logIndex)
+		case DiffFieldSigner:
+			msg = NewMsgSignerUpdate(from, diff.ID.Uint64(), hmTypes.NewPubKey(uncompressedPubKey(signerPubKey)), txHash, logIndex)
+		case DiffFieldDeactivationEpoch:
+			msg = NewMsgValidatorExit(from, diff.ID.Uint64(), txHash, logIndex)
+		case DiffFieldPower:
+			msg = NewMsgStakeUpdate(from, diff.ID.Uint64(), txHash, logIndex)
+		}
+
+		result = append(result, CorrectingMsg{
+			Diff:     diff,
+			Msg:      msg,
+			TxHash:   txHash,
+			LogIndex: logIndex,
+		})
+	}
+
+	return result, nil
+}
+
+// uncompressedPubKey appends prefix "0x04" to public key from StakingInfo event, as heimdall uses uncompressed format
+func uncompressedPubKey(signerPubKey []byte) []byte {
+	if len(signerPubKey) == 64 {
+		return append([]byte{0x04}, signerPubKey...)
+	}
+	return signerPubKey
+}
@@ -0,0 +1,246 @@
+package types
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/contracts/stakemanager"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// fakeStakingBackend serves current validator set of StakeManager and logs of StakingInfo to contract bindings
+type fakeStakingBackend struct {
+	bind.ContractBackend
+
+	currentValidatorSet []*big.Int
+	logs                []ethTypes.Log
+}
+
+func parseABI(t *testing.T, abiJSON string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	require.NoError(t, err)
+	return parsed
+}
+
+func (b *fakeStakingBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	parsed, err := abi.JSON(strings.NewReader(stakemanager.StakemanagerABI))
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Methods["getCurrentValidatorSet"].Outputs.Pack(b.currentValidatorSet)
+}
+
+func (b *fakeStakingBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]ethTypes.Log, error) {
+	result := make([]ethTypes.Log, 0)
+	for _, vLog := range b.logs {
+		if vLog.BlockNumber < query.FromBlock.Uint64() || (query.ToBlock != nil && vLog.BlockNumber > query.ToBlock.Uint64()) {
+			continue
+		}
+
+		matched := true
+		for i, topics := range query.Topics {
+			if len(topics) == 0 {
+				continue
+			}
+
+			found := false
+			for _, topic := range topics {
+				found = found || (i < len(vLog.Topics) && vLog.Topics[i] == topic)
+			}
+			matched = matched && found
+		}
+
+		if matched {
+			result = append(result, vLog)
+		}
+	}
+	return result, nil
+}
+
+// addLog adds StakingInfo event log with indexed topics and abi encoded data
+func (b *fakeStakingBackend) addLog(t *testing.T, name string, blockNumber uint64, indexed []common.Hash, data ...interface{}) ethTypes.Log {
+	event := parseABI(t, stakinginfo.StakinginfoABI).Events[name]
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	require.NoError(t, err)
+
+	vLog := ethTypes.Log{
+		Topics:      append([]common.Hash{event.Id()}, indexed...),
+		Data:        packed,
+		BlockNumber: blockNumber,
+		TxHash:      common.BytesToHash([]byte{byte(blockNumber), byte(len(b.logs))}),
+		Index:       uint(len(b.logs)),
+	}
+	b.logs = append(b.logs, vLog)
+	return vLog
+}
+
+// newFakeContractCaller returns contract caller with staking contract bindings on fake backend
+func newFakeContractCaller(t *testing.T, backend *fakeStakingBackend) *mocks.IContractCaller {
+	stakingInfoInstance, err := stakinginfo.NewStakinginfo(common.Address{}, backend)
+	require.NoError(t, err)
+
+	stakeManagerInstance, err := stakemanager.NewStakemanager(common.Address{}, backend)
+	require.NoError(t, err)
+
+	contractCaller := &mocks.IContractCaller{}
+	contractCaller.On("GetStakingInfoInstance", mock.Anything).Return(stakingInfoInstance, nil)
+	contractCaller.On("GetStakeManagerInstance", mock.Anything).Return(stakeManagerInstance, nil)
+	return contractCaller
+}
+
+func idTopic(id uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(id))
+}
+
+func addressTopic(address common.Address) common.Hash {
+	return common.BytesToHash(address.Bytes())
+}
+
+func TestCompareValidator(t *testing.T) {
+	validator := hmTypes.Validator{
+		ID:          hmTypes.NewValidatorID(1),
+		VotingPower: 10,
+		Signer:      hmTypes.HexToHeimdallAddress("0x1"),
+	}
+
+	require.Empty(t, CompareValidator(validator, validator))
+
+	// lost stake update and signer change
+	onchain := validator
+	onchain.VotingPower = 20
+	onchain.Signer = hmTypes.HexToHeimdallAddress("0x2")
+
+	diffs := CompareValidator(validator, onchain)
+	require.Len(t, diffs, 2)
+	require.Equal(t, DiffFieldSigner, diffs[0].Field)
+	require.Equal(t, DiffFieldPower, diffs[1].Field)
+	require.Equal(t, "10", diffs[1].Heimdall)
+	require.Equal(t, "20", diffs[1].Onchain)
+
+	// lost unstake init, stake of exiting validator is not compared
+	onchain = validator
+	onchain.EndEpoch = 5
+	onchain.VotingPower = 0
+
+	diffs = CompareValidator(validator, onchain)
+	require.Len(t, diffs, 1)
+	require.Equal(t, DiffFieldDeactivationEpoch, diffs[0].Field)
+
+	validator.EndEpoch = 5
+	require.Empty(t, CompareValidator(validator, onchain))
+}
+
+func TestReconcileValidators(t *testing.T) {
+	backend := &fakeStakingBackend{currentValidatorSet: []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}}
+	contractCaller := newFakeContractCaller(t, backend)
+
+	validators := []*hmTypes.Validator{
+		{ID: hmTypes.NewValidatorID(1), VotingPower: 10, Signer: hmTypes.HexToHeimdallAddress("0x1")},
+		{ID: hmTypes.NewValidatorID(2), VotingPower: 10, Signer: hmTypes.HexToHeimdallAddress("0x2")},
+	}
+
+	// validator 2 missed stake update, validator 3 missed validator join
+	onchain := []hmTypes.Validator{*validators[0], *validators[1], {ID: hmTypes.NewValidatorID(3), VotingPower: 10, Signer: hmTypes.HexToHeimdallAddress("0x3")}}
+	onchain[1].VotingPower = 20
+	for _, validator := range onchain {
+		contractCaller.On("GetValidatorInfo", validator.ID, mock.Anything).Return(validator, nil)
+	}
+
+	diffs, err := ReconcileValidators(contractCaller, common.Address{}, common.Address{}, validators)
+	require.NoError(t, err)
+	require.Equal(t, []ValidatorDiff{
+		{ID: hmTypes.NewValidatorID(2), Field: DiffFieldPower, Heimdall: "10", Onchain: "20"},
+		{ID: hmTypes.NewValidatorID(3), Field: DiffFieldMissing, Onchain: onchain[2].Signer.String()},
+	}, diffs)
+}
+
+func TestGetCorrectingMsgs(t *testing.T) {
+	backend := &fakeStakingBackend{}
+	contractCaller := newFakeContractCaller(t, backend)
+
+	pubKey := make([]byte, 64)
+	pubKey[0] = 1
+	signer := common.HexToAddress("0x1")
+	amount := big.NewInt(10)
+
+	// events before start block, within range, after end block and of other validators
+	backend.addLog(t, "SignerChange", 5, []common.Hash{idTopic(1), addressTopic(signer), addressTopic(signer)}, pubKey)
+	backend.addLog(t, "SignerChange", 10, []common.Hash{idTopic(1), addressTopic(signer), addressTopic(signer)}, pubKey)
+	signerChange := backend.addLog(t, "SignerChange", 20, []common.Hash{idTopic(1), addressTopic(signer), addressTopic(signer)}, pubKey)
+	stakeUpdate := backend.addLog(t, "StakeUpdate", 15, []common.Hash{idTopic(2), common.BigToHash(amount)})
+	backend.addLog(t, "StakeUpdate", 25, []common.Hash{idTopic(6), common.BigToHash(amount)})
+	staked := backend.addLog(t, "Staked", 12, []common.Hash{addressTopic(signer), idTopic(3), idTopic(1)}, amount, amount, pubKey)
+	unstakeInit := backend.addLog(t, "UnstakeInit", 18, []common.Hash{addressTopic(signer), idTopic(4), common.BigToHash(amount)}, big.NewInt(5))
+	backend.addLog(t, "StakeUpdate", 40, []common.Hash{idTopic(5), common.BigToHash(amount)})
+
+	diffs := []ValidatorDiff{
+		{ID: hmTypes.NewValidatorID(1), Field: DiffFieldSigner},
+		{ID: hmTypes.NewValidatorID(2), Field: DiffFieldPower},
+		{ID: hmTypes.NewValidatorID(3), Field: DiffFieldMissing},
+		{ID: hmTypes.NewValidatorID(4), Field: DiffFieldDeactivationEpoch},
+		{ID: hmTypes.NewValidatorID(5), Field: DiffFieldPower},
+	}
+
+	from := hmTypes.HexToHeimdallAddress("0xabc")
+	correctingMsgs, err := GetCorrectingMsgs(contractCaller, common.Address{}, from, diffs, 10, 30)
+	require.NoError(t, err)
+
+	// no msg for validator 5, its event is after end block
+	require.Len(t, correctingMsgs, 4)
+
+	txHash := func(vLog ethTypes.Log) hmTypes.HeimdallHash {
+		return hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes())
+	}
+	uncompressed := hmTypes.NewPubKey(append([]byte{0x04}, pubKey...))
+
+	// latest event is replayed
+	require.Equal(t, NewMsgSignerUpdate(from, 1, uncompressed, txHash(signerChange), uint64(signerChange.Index)), correctingMsgs[0].Msg)
+	require.Equal(t, txHash(signerChange), correctingMsgs[0].TxHash)
+	require.Equal(t, uint64(signerChange.Index), correctingMsgs[0].LogIndex)
+	require.Equal(t, diffs[0], correctingMsgs[0].Diff)
+
+	require.Equal(t, NewMsgStakeUpdate(from, 2, txHash(stakeUpdate), uint64(stakeUpdate.Index)), correctingMsgs[1].Msg)
+	require.Equal(t, NewMsgValidatorJoin(from, 3, uncompressed, txHash(staked), uint64(staked.Index)), correctingMsgs[2].Msg)
+	require.Equal(t, NewMsgValidatorExit(from, 4, txHash(unstakeInit), uint64(unstakeInit.Index)), correctingMsgs[3].Msg)
+
+	// latest event until end block is replayed
+	correctingMsgs, err = GetCorrectingMsgs(contractCaller, common.Address{}, from, diffs[:1], 0, 15)
+	require.NoError(t, err)
+	require.Len(t, correctingMsgs, 1)
+	require.Equal(t, uint64(1), correctingMsgs[0].LogIndex)
+
+	// events before start block are not searched
+	correctingMsgs, err = GetCorrectingMsgs(contractCaller, common.Address{}, from, diffs[:1], 6, 9)
+	require.NoError(t, err)
+	require.Empty(t, correctingMsgs)
+}
+
+func TestSkipPendingSignerDiffs(t *testing.T) {
+	newSigner := hmTypes.HexToHeimdallAddress("0x2")
+	diffs := []ValidatorDiff{
+		{ID: hmTypes.NewValidatorID(1), Field: DiffFieldSigner, Heimdall: hmTypes.HexToHeimdallAddress("0x1").String(), Onchain: newSigner.String()},
+		{ID: hmTypes.NewValidatorID(1), Field: DiffFieldPower, Heimdall: "10", Onchain: "20"},
+		{ID: hmTypes.NewValidatorID(2), Field: DiffFieldSigner, Heimdall: hmTypes.HexToHeimdallAddress("0x1").String(), Onchain: hmTypes.HexToHeimdallAddress("0x3").String()},
+	}
+
+	pendingUpdates := []PendingSignerUpdate{
+		{ValidatorID: hmTypes.NewValidatorID(1), NewSigner: newSigner},
+		// signer changed again on chain after rotation was staged
+		{ValidatorID: hmTypes.NewValidatorID(2), NewSigner: newSigner},
+	}
+
+	require.Equal(t, diffs, SkipPendingSignerDiffs(diffs, nil))
+	require.Equal(t, diffs[1:], SkipPendingSignerDiffs(diffs, pendingUpdates))
+}
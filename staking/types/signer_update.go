@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// PendingSignerUpdate is signer rotation staged until next checkpoint ack, old signer stays valid until then
+type PendingSignerUpdate struct {
+	ValidatorID       hmTypes.ValidatorID     `json:"ID"`
+	NewSigner         hmTypes.HeimdallAddress `json:"new_signer"`
+	NewPubKey         hmTypes.PubKey          `json:"new_pub_key"`
+	EffectiveAckCount uint64                  `json:"effective_ack_count"` // rotation is applied once ack count reaches it
+}
+
+// NewPendingSignerUpdate creates new pending signer update
+func NewPendingSignerUpdate(id hmTypes.ValidatorID, newPubKey hmTypes.PubKey, effectiveAckCount uint64) PendingSignerUpdate {
+	return PendingSignerUpdate{
+		ValidatorID:       id,
+		NewSigner:         hmTypes.BytesToHeimdallAddress(newPubKey.Address().Bytes()),
+		NewPubKey:         newPubKey,
+		EffectiveAckCount: effectiveAckCount,
+	}
+}
+
+// String returns string representation of pending signer update
+func (u PendingSignerUpdate) String() string {
+	return fmt.Sprintf("PendingSignerUpdate{%v %v effective at ack %v}", u.ValidatorID, u.NewSigner.String(), u.EffectiveAckCount)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"bytes"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SnapshotValidator is compact form of validator stored in validator set snapshot
+type SnapshotValidator struct {
+	ID          hmTypes.ValidatorID     `json:"ID"`
+	Signer      hmTypes.HeimdallAddress `json:"signer"`
+	VotingPower int64                   `json:"power"`
+}
+
+// ValidatorSetSnapshot is validator set stored at height where it was changed
+type ValidatorSetSnapshot struct {
+	Height           int64               `json:"height"`
+	Validators       []SnapshotValidator `json:"validators"`
+	TotalVotingPower int64               `json:"total_voting_power"`
+}
+
+// CheckpointValidatorSet is height of validator set snapshot which signed checkpoint of chain
+type CheckpointValidatorSet struct {
+	ChainID          string `json:"chain_id"`
+	CheckpointNumber uint64 `json:"checkpoint_number"`
+	Height           int64  `json:"height"`
+}
+
+// NewValidatorSetSnapshot creates snapshot of validator set at height
+func NewValidatorSetSnapshot(height int64, validatorSet hmTypes.ValidatorSet) ValidatorSetSnapshot {
+	snapshot := ValidatorSetSnapshot{
+		Height:     height,
+		Validators: make([]SnapshotValidator, 0, len(validatorSet.Validators)),
+	}
+
+	for _, validator := range validatorSet.Validators {
+		snapshot.Validators = append(snapshot.Validators, SnapshotValidator{
+			ID:          validator.ID,
+			Signer:      validator.Signer,
+			VotingPower: validator.VotingPower,
+		})
+		snapshot.TotalVotingPower += validator.VotingPower
+	}
+
+	return snapshot
+}
+
+// HasSameValidators checks if both snapshots have same validators with same power
+func (s ValidatorSetSnapshot) HasSameValidators(other ValidatorSetSnapshot) bool {
+	if len(s.Validators) != len(other.Validators) {
+		return false
+	}
+
+	for i, validator := range s.Validators {
+		o := other.Validators[i]
+		if validator.ID != o.ID || validator.VotingPower != o.VotingPower || !bytes.Equal(validator.Signer.Bytes(), o.Signer.Bytes()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	hmClient "github.com/maticnetwork/heimdall/client"
 	"github.com/maticnetwork/heimdall/helper"
 	topupTypes "github.com/maticnetwork/heimdall/topup/types"
@@ -61,12 +62,54 @@ func TopupTxCmd(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("transaction hash has to be supplied")
 			}
 
+			// signer, fee and block number are optional, missing ones are read from topup event on root chain
+			fields := topupTypes.TopupEventFields{
+				Signer:      types.HexToHeimdallAddress(viper.GetString(FlagSignerAddress)),
+				BlockNumber: viper.GetUint64(FlagBlockNumber),
+			}
+
+			if feeStr := viper.GetString(FlagFeeAmount); feeStr != "" {
+				fee, ok := big.NewInt(0).SetString(feeStr, 10)
+				if !ok {
+					return errors.New("Invalid fee amount")
+				}
+				fields.Fee = fee
+			}
+
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+
+			if !fields.IsComplete() {
+				contractCallerObj, err := helper.NewContractCaller()
+				if err != nil {
+					return err
+				}
+
+				configParams, err := util.GetConfigManagerParams(cliCtx)
+				if err != nil {
+					return err
+				}
+
+				fields, err = topupTypes.FillTopupEventFields(
+					&contractCallerObj,
+					configParams.ChainParams,
+					configParams.TxConfirmationTime,
+					uint64(validatorID),
+					types.HexToHeimdallHash(txhash),
+					logIndex,
+					fields,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
 			// build and sign the transaction, then broadcast to Tendermint
-			msg := topupTypes.NewMsgTopup(
+			msg := topupTypes.NewMsgTopupWithEventFields(
 				proposer,
 				uint64(validatorID),
 				types.HexToHeimdallHash(txhash),
-				uint64(viper.GetInt64(FlagLogIndex)),
+				logIndex,
+				fields,
 			)
 
 			// broadcast msg with cli
@@ -77,6 +120,9 @@ func TopupTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
 	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
 	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
+	cmd.Flags().String(FlagSignerAddress, "", "--signer=<signer from topup event> (optional, read from root chain if missing)")
+	cmd.Flags().String(FlagFeeAmount, "", "--fee-amount=<fee from topup event> (optional, read from root chain if missing)")
+	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block-number=<block number of topup tx> (optional, read from root chain if missing)")
 	cmd.MarkFlagRequired(FlagValidatorID)
 	cmd.MarkFlagRequired(FlagTxHash)
 	cmd.MarkFlagRequired(FlagLogIndex)
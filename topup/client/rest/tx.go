@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
 	"net/http"
 
@@ -9,7 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	restClient "github.com/maticnetwork/heimdall/client/rest"
+	"github.com/maticnetwork/heimdall/helper"
 	topupTypes "github.com/maticnetwork/heimdall/topup/types"
 	"github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/rest"
@@ -29,9 +33,12 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 type TopupReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 
-	ID       uint64 `json:"id" yaml:"id"`
-	TxHash   string `json:"tx_hash" yaml:"tx_hash"`
-	LogIndex uint64 `json:"log_index" yaml:"log_index"`
+	ID          uint64 `json:"id" yaml:"id"`
+	Signer      string `json:"signer" yaml:"signer"`
+	Fee         string `json:"fee" yaml:"fee"`
+	TxHash      string `json:"tx_hash" yaml:"tx_hash"`
+	LogIndex    uint64 `json:"log_index" yaml:"log_index"`
+	BlockNumber uint64 `json:"block_number" yaml:"block_number"`
 }
 
 // TopupHandlerFn - http request handler to topup coins to a address.
@@ -50,12 +57,64 @@ func TopupHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		// get from address
 		fromAddr := types.HexToHeimdallAddress(req.BaseReq.From)
 
+		// signer, fee and block number are optional, missing ones are read from topup event on root chain
+		fields := topupTypes.TopupEventFields{
+			Signer:      types.HexToHeimdallAddress(req.Signer),
+			BlockNumber: req.BlockNumber,
+		}
+
+		if req.Fee != "" {
+			fee, ok := big.NewInt(0).SetString(req.Fee, 10)
+			if !ok {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "Bad fee")
+				return
+			}
+			fields.Fee = fee
+		}
+
+		txHash := types.HexToHeimdallHash(req.TxHash)
+
+		if !fields.IsComplete() {
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", chainmanagerTypes.QuerierRoute, chainmanagerTypes.QueryParams), nil)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+
+			var params chainmanagerTypes.Params
+			if err := json.Unmarshal(res, &params); err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+
+			fields, err = topupTypes.FillTopupEventFields(
+				&contractCallerObj,
+				params.ChainParams,
+				params.TxConfirmationTime,
+				req.ID,
+				txHash,
+				req.LogIndex,
+				fields,
+			)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
 		// get msg
-		msg := topupTypes.NewMsgTopup(
+		msg := topupTypes.NewMsgTopupWithEventFields(
 			fromAddr,
 			req.ID,
-			types.HexToHeimdallHash(req.TxHash),
+			txHash,
 			req.LogIndex,
+			fields,
 		)
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
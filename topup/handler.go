@@ -1,11 +1,11 @@
 package topup
 
 import (
+	"fmt"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/maticnetwork/heimdall/auth"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
@@ -20,94 +20,40 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 
 		switch msg := msg.(type) {
 		case types.MsgTopup:
-			return handleMsgTopup(ctx, k, msg, contractCaller)
+			return handleMsgTopup(ctx, k, msg)
 		case types.MsgWithdrawFee:
 			return handleMsgWithdrawFee(ctx, k, msg)
 		default:
-			errMsg := "Unrecognized topup Msg type: %s" + msg.Type()
+			errMsg := fmt.Sprintf("Unrecognized topup Msg type: %s", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
 		}
 	}
 }
 
-// Handle MsgMintFeeToken
-func handleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, contractCaller helper.IContractCaller) sdk.Result {
+// Handle MsgTopup, only state is checked here. Topup is verified against root chain
+// by side handler and applied by post handler once validators vote for it.
+func handleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup) sdk.Result {
 	if !k.bk.GetSendEnabled(ctx) {
 		return types.ErrSendDisabled(k.Codespace()).Result()
 	}
 
-	// chainManager params
-	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
-
-	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(ctx.BlockTime(), msg.TxHash.EthHash(), params.TxConfirmationTime)
-	if err != nil || receipt == nil {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace(), params.TxConfirmationTime).Result()
-	}
-
-	// get event log for topup
-	eventLog, err := contractCaller.DecodeValidatorTopupFeesEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
-	if err != nil || eventLog == nil {
-		k.Logger(ctx).Error("Error fetching log from txhash")
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to fetch logs for txHash").Result()
-	}
-
-	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
-		k.Logger(ctx).Error("ID in message doesn't match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid txhash and id don't match. Id from tx hash is %v", eventLog.ValidatorId.Uint64()).Result()
-	}
-
-	// use event log signer
-	signer := hmTypes.BytesToHeimdallAddress(eventLog.Signer.Bytes())
-	// if validator exists use siger from local state
-	validator, found := k.sk.GetValidatorFromValID(ctx, msg.ID)
-	if found {
-		signer = validator.Signer
-	}
-
-	// create topup amount
-	topupAmount := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: sdk.NewIntFromBigInt(eventLog.Fee)}}
-
-	// sequence id
-
-	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
-	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
-
 	// check if incoming tx already exists
-	if k.HasTopupSequence(ctx, sequence.String()) {
+	if k.HasTopupSequence(ctx, getTopupSequence(msg).String()) {
 		k.Logger(ctx).Error("Older invalid tx found")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
 
-	// increase coins in account
-	if _, err := k.bk.AddCoins(ctx, signer, topupAmount); err != nil {
-		return err.Result()
-	}
-
-	// transfer fees to sender (proposer)
-	if err := k.bk.SendCoins(ctx, signer, msg.FromAddress, auth.DefaultFeeWantedPerTx); err != nil {
-		return err.Result()
-	}
-
-	// save topup
-	k.SetTopupSequence(ctx, sequence.String())
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeTopup,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyValidatorID, msg.ID.String()),
-			sdk.NewAttribute(types.AttributeKeyValidatorSigner, signer.String()),
-			sdk.NewAttribute(types.AttributeKeyTopupAmount, eventLog.Fee.String()),
-		),
-	})
-
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
 }
 
+// getTopupSequence returns sequence id of topup event
+func getTopupSequence(msg types.MsgTopup) *big.Int {
+	sequence := new(big.Int).Mul(new(big.Int).SetUint64(msg.BlockNumber), big.NewInt(hmTypes.DefaultLogIndexUnit))
+	return sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+}
+
 // Handle MsgWithdrawFee.
 func handleMsgWithdrawFee(ctx sdk.Context, k Keeper, msg types.MsgWithdrawFee) sdk.Result {
 
@@ -23,6 +23,7 @@ var (
 	_ module.AppModule             = AppModule{}
 	_ module.AppModuleBasic        = AppModuleBasic{}
 	_ hmModule.HeimdallModuleBasic = AppModule{}
+	_ hmModule.SideModule          = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the auth module.
@@ -111,6 +112,16 @@ func (am AppModule) NewHandler() sdk.Handler {
 	return NewHandler(am.keeper, am.contractCaller)
 }
 
+// NewSideTxHandler returns side handler verifying topup msgs on root chain.
+func (am AppModule) NewSideTxHandler() hmModule.SideTxHandler {
+	return NewSideTxHandler(am.keeper, am.contractCaller)
+}
+
+// NewPostTxHandler returns post handler applying verified topup msgs.
+func (am AppModule) NewPostTxHandler() hmModule.PostTxHandler {
+	return NewPostTxHandler(am.keeper)
+}
+
 // QuerierRoute returns the auth module's querier route name.
 func (AppModule) QuerierRoute() string {
 	return types.QuerierRoute
@@ -0,0 +1,140 @@
+package topup
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/auth"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/topup/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+// NewSideTxHandler returns a side handler for "topup" type messages.
+func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmModule.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) hmModule.SideTxResult {
+		switch msg := msg.(type) {
+		case types.MsgTopup:
+			return sideHandleMsgTopup(ctx, k, msg, contractCaller)
+		default:
+			return hmModule.VoteSkip
+		}
+	}
+}
+
+// sideHandleMsgTopup verifies topup event on root chain
+func sideHandleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, contractCaller helper.IContractCaller) hmModule.SideTxResult {
+	// chainManager params
+	params := k.chainKeeper.GetParams(ctx)
+	chainParams := params.ChainParams
+
+	// get main tx receipt, not voting until tx is confirmed
+	receipt, err := contractCaller.GetConfirmedTxReceipt(ctx.BlockTime(), msg.TxHash.EthHash(), params.TxConfirmationTime)
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Info("Topup tx is not confirmed yet", "txHash", msg.TxHash)
+		return hmModule.VoteSkip
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber)
+		return hmModule.VoteNo
+	}
+
+	// get event log for topup
+	eventLog, err := contractCaller.DecodeValidatorTopupFeesEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmModule.VoteNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
+		return hmModule.VoteNo
+	}
+
+	if !bytes.Equal(eventLog.Signer.Bytes(), msg.Signer.Bytes()) {
+		k.Logger(ctx).Error("Signer in message doesn't match signer in logs", "MsgSigner", msg.Signer, "SignerFromTx", eventLog.Signer.Hex())
+		return hmModule.VoteNo
+	}
+
+	if eventLog.Fee.Cmp(msg.Fee.BigInt()) != 0 {
+		k.Logger(ctx).Error("Fee in message doesn't match fee in logs", "MsgFee", msg.Fee, "FeeFromTx", eventLog.Fee)
+		return hmModule.VoteNo
+	}
+
+	return hmModule.VoteYes
+}
+
+// NewPostTxHandler returns a post handler for "topup" type messages.
+func NewPostTxHandler(k Keeper) hmModule.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg, result hmModule.SideTxResult) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgTopup:
+			return postHandleMsgTopup(ctx, k, msg, result)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized topup Msg type: %s", msg.Type())
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// postHandleMsgTopup mints topup fee once validators verified topup event
+func postHandleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, result hmModule.SideTxResult) sdk.Result {
+	if result != hmModule.VoteYes {
+		k.Logger(ctx).Debug("Skipping topup, not approved by validators", "txHash", msg.TxHash, "logIndex", msg.LogIndex, "result", result)
+		return sdk.Result{}
+	}
+
+	// sequence id
+	sequence := getTopupSequence(msg)
+
+	// check if incoming tx already exists
+	if k.HasTopupSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	// use event log signer
+	signer := msg.Signer
+	// if validator exists use siger from local state
+	validator, found := k.sk.GetValidatorFromValID(ctx, msg.ID)
+	if found {
+		signer = validator.Signer
+	}
+
+	// create topup amount
+	topupAmount := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: msg.Fee}}
+
+	// increase coins in account
+	if _, err := k.bk.AddCoins(ctx, signer, topupAmount); err != nil {
+		return err.Result()
+	}
+
+	// transfer fees to sender (proposer)
+	if err := k.bk.SendCoins(ctx, signer, msg.FromAddress, auth.DefaultFeeWantedPerTx); err != nil {
+		return err.Result()
+	}
+
+	// save topup
+	k.SetTopupSequence(ctx, sequence.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTopup,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorSigner, signer.String()),
+			sdk.NewAttribute(types.AttributeKeyTopupAmount, msg.Fee.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
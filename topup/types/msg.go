@@ -5,6 +5,7 @@ import (
 
 	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
 )
 
 //
@@ -12,27 +13,37 @@ import (
 //
 
 // MsgTopup - high level transaction of the fee coin module
+// Topup is a side-tx msg, validators verify topup event (signer and fee) on root chain before it is applied.
 type MsgTopup struct {
 	FromAddress types.HeimdallAddress `json:"from_address"`
 	ID          types.ValidatorID     `json:"id"`
+	Signer      types.HeimdallAddress `json:"signer"`
+	Fee         sdk.Int               `json:"fee"`
 	TxHash      types.HeimdallHash    `json:"tx_hash"`
 	LogIndex    uint64                `json:"log_index"`
+	BlockNumber uint64                `json:"block_number"`
 }
 
-var _ sdk.Msg = MsgTopup{}
+var _ hmModule.SideTxMsg = MsgTopup{}
 
 // NewMsgTopup - construct arbitrary multi-in, multi-out send msg.
 func NewMsgTopup(
 	fromAddr types.HeimdallAddress,
 	id uint64,
+	signer types.HeimdallAddress,
+	fee sdk.Int,
 	txhash types.HeimdallHash,
 	logIndex uint64,
+	blockNumber uint64,
 ) MsgTopup {
 	return MsgTopup{
 		FromAddress: fromAddr,
 		ID:          types.NewValidatorID(id),
+		Signer:      signer,
+		Fee:         fee,
 		TxHash:      txhash,
 		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
 	}
 }
 
@@ -60,6 +71,14 @@ func (msg MsgTopup) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.FromAddress.String())
 	}
 
+	if msg.Signer.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid signer %v", msg.Signer.String())
+	}
+
+	if !msg.Fee.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid fee %v", msg.Fee.String())
+	}
+
 	return nil
 }
 
@@ -68,6 +87,13 @@ func (msg MsgTopup) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
 
+// GetSideSignBytes returns sign bytes without proposer, so that the same topup
+// submitted by different proposers is voted on once
+func (msg MsgTopup) GetSideSignBytes() []byte {
+	msg.FromAddress = types.HeimdallAddress{}
+	return msg.GetSignBytes()
+}
+
 // GetSigners Implements Msg.
 func (msg MsgTopup) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.FromAddress)}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// TopupEventFields are signer, fee and block number of a topup event.
+// Clients built before topup became a side-tx only provide validator id, tx hash and log index,
+// missing fields are filled from the TopUpFee event on root chain.
+type TopupEventFields struct {
+	Signer      hmTypes.HeimdallAddress
+	Fee         *big.Int
+	BlockNumber uint64
+}
+
+// IsComplete returns true if no field has to be fetched from root chain
+func (f TopupEventFields) IsComplete() bool {
+	return !f.Signer.Empty() && f.Fee != nil && f.BlockNumber != 0
+}
+
+// FillTopupEventFields fills missing signer, fee and block number from the confirmed topup tx on root chain
+func FillTopupEventFields(
+	contractCaller helper.IContractCaller,
+	chainParams chainmanagerTypes.ChainParams,
+	txConfirmationTime time.Duration,
+	validatorID uint64,
+	txHash hmTypes.HeimdallHash,
+	logIndex uint64,
+	fields TopupEventFields,
+) (TopupEventFields, error) {
+	if fields.IsComplete() {
+		return fields, nil
+	}
+
+	receipt, err := contractCaller.GetConfirmedTxReceipt(time.Now().UTC(), txHash.EthHash(), txConfirmationTime)
+	if err != nil || receipt == nil {
+		return fields, errors.New("Transaction is not confirmed yet. Please wait for sometime and try again")
+	}
+
+	eventLog, err := contractCaller.DecodeValidatorTopupFeesEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, logIndex)
+	if err != nil || eventLog == nil {
+		return fields, errors.New("Unable to decode topup event from transaction")
+	}
+
+	if eventLog.ValidatorId.Uint64() != validatorID {
+		return fields, fmt.Errorf("Validator ID %d does not match topup event validator ID %d", validatorID, eventLog.ValidatorId.Uint64())
+	}
+
+	if fields.Signer.Empty() {
+		fields.Signer = hmTypes.BytesToHeimdallAddress(eventLog.Signer.Bytes())
+	}
+
+	if fields.Fee == nil {
+		fields.Fee = eventLog.Fee
+	}
+
+	if fields.BlockNumber == 0 {
+		fields.BlockNumber = receipt.BlockNumber.Uint64()
+	}
+
+	return fields, nil
+}
+
+// NewMsgTopupWithEventFields creates topup msg from event fields
+func NewMsgTopupWithEventFields(
+	fromAddr hmTypes.HeimdallAddress,
+	validatorID uint64,
+	txHash hmTypes.HeimdallHash,
+	logIndex uint64,
+	fields TopupEventFields,
+) MsgTopup {
+	return NewMsgTopup(
+		fromAddr,
+		validatorID,
+		fields.Signer,
+		sdk.NewIntFromBigInt(fields.Fee),
+		txHash,
+		logIndex,
+		fields.BlockNumber,
+	)
+}
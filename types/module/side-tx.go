@@ -0,0 +1,69 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SideTxResult is a validator's vote on a side-tx
+type SideTxResult int32
+
+const (
+	// VoteSkip validator couldn't verify side-tx (eg. external data is not available yet)
+	VoteSkip SideTxResult = iota
+	// VoteYes side-tx matches external chain
+	VoteYes
+	// VoteNo side-tx doesn't match external chain
+	VoteNo
+)
+
+// String returns vote name
+func (r SideTxResult) String() string {
+	switch r {
+	case VoteYes:
+		return "yes"
+	case VoteNo:
+		return "no"
+	default:
+		return "skip"
+	}
+}
+
+// ParseSideTxResult parses vote name (yes or no)
+func ParseSideTxResult(vote string) (SideTxResult, error) {
+	switch strings.ToLower(vote) {
+	case "yes":
+		return VoteYes, nil
+	case "no":
+		return VoteNo, nil
+	default:
+		return VoteSkip, fmt.Errorf("Invalid vote %v, must be yes or no", vote)
+	}
+}
+
+// SideTxHandler verifies msg against external chain (outside of state machine) and returns validator's vote
+type SideTxHandler func(ctx sdk.Context, msg sdk.Msg) SideTxResult
+
+// PostTxHandler applies (on VoteYes) or rejects (on VoteNo) msg once side-tx voting is concluded
+type PostTxHandler func(ctx sdk.Context, msg sdk.Msg, result SideTxResult) sdk.Result
+
+// SideTxMsg is msg which is verified against external chain through side-tx voting.
+// Module handler only runs state checks for it, state change is applied by post handler.
+type SideTxMsg interface {
+	sdk.Msg
+
+	// GetSideSignBytes returns bytes identifying external data of msg, same data submitted
+	// by different proposers results in the same side-tx
+	GetSideSignBytes() []byte
+}
+
+// SideModule is a module with side-tx msgs
+type SideModule interface {
+	// NewSideTxHandler returns handler verifying side-tx msgs of module
+	NewSideTxHandler() SideTxHandler
+
+	// NewPostTxHandler returns handler applying side-tx msgs of module
+	NewPostTxHandler() PostTxHandler
+}
@@ -20,6 +20,7 @@ type Validator struct {
 	PubKey      PubKey          `json:"pubKey"`
 	Signer      HeimdallAddress `json:"signer"`
 	LastUpdated string          `json:"last_updated"`
+	Jailed      bool            `json:"jailed"`
 
 	ProposerPriority int64 `json:"accum"`
 }
@@ -48,8 +49,8 @@ func (v *Validator) IsCurrentValidator(ackCount uint64) bool {
 	// current epoch will be ack count + 1
 	currentEpoch := ackCount + 1
 
-	// validator hasnt initialised unstake
-	if v.StartEpoch <= currentEpoch && (v.EndEpoch == 0 || v.EndEpoch > currentEpoch) && v.VotingPower > 0 {
+	// validator hasnt initialised unstake and is not jailed
+	if v.StartEpoch <= currentEpoch && (v.EndEpoch == 0 || v.EndEpoch > currentEpoch) && v.VotingPower > 0 && !v.Jailed {
 		return true
 	}
 